@@ -0,0 +1,106 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Job is the work an entry runs at each of its activations, see
+// [Cron.ScheduleContext].
+type Job func(ctx context.Context) error
+
+// JobWrapper decorates a job with cross-cutting behavior: logging, tracing,
+// distributed locking and the like. See [WithChain] and [EntryChain].
+//
+// The wrapped job is called for every run, with the context of the run, see
+// [RunInfoFromContext]. It runs within the timeout of the run and its panics
+// are recovered like those of the job.
+type JobWrapper func(Job) Job
+
+// Chain composes the given wrappers into one, the first given being the
+// outermost: Chain(a, b)(job) is a(b(job)).
+func Chain(wrappers ...JobWrapper) JobWrapper {
+	return func(job Job) Job {
+		for i := len(wrappers) - 1; i >= 0; i-- {
+			job = wrappers[i](job)
+		}
+		return job
+	}
+}
+
+// Recover turns the panics of the wrapped job into errors, wrapping the value
+// panicked with if an error, so that the wrappers around it see them as
+// failures. The scheduler recovers from the panics reaching it anyway.
+func Recover() JobWrapper {
+	return func(job Job) Job {
+		return func(ctx context.Context) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					if e, ok := r.(error); ok {
+						err = fmt.Errorf("job panicked: %w", e)
+					} else {
+						err = fmt.Errorf("job panicked: %v", r)
+					}
+				}
+			}()
+			return job(ctx)
+		}
+	}
+}
+
+// Timeout cancels the context of the wrapped job after d. Unlike the timeout
+// of the runs, see [RunTimeout], it bounds only the job, not the wrappers
+// around it.
+func Timeout(d time.Duration) JobWrapper {
+	return func(job Job) Job {
+		return func(ctx context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+			return job(ctx)
+		}
+	}
+}
+
+// SkipIfStillRunning skips a call of the wrapped job while the previous one is
+// still in progress, logging it. Like the overlap policy set by
+// [EntrySkipIfRunning], it guards each job it wraps separately, but only the
+// wrapped job, letting the wrappers around it run.
+func SkipIfStillRunning(logger *slog.Logger) JobWrapper {
+	return func(job Job) Job {
+		guard := newOverlapGuard(OverlapSkip, 1, 0)
+		return func(ctx context.Context) error {
+			dispatch, t := guard.admit(func() {})
+			if dispatch == DispatchSkipped {
+				run, _ := RunInfoFromContext(ctx)
+				logger.Info("job execution skipped", "event", "skip", "id", run.ID, "name", run.Name)
+				return nil
+			}
+			defer guard.release(t)
+			return job(ctx)
+		}
+	}
+}
+
+// DelayIfStillRunning defers a call of the wrapped job while the previous one
+// is still in progress, calling it once that one returns, logging the delays
+// over a minute. Like the overlap policy set by [EntryQueueIfRunning], it
+// guards each job it wraps separately, but only the wrapped job, letting the
+// wrappers around it run.
+func DelayIfStillRunning(logger *slog.Logger) JobWrapper {
+	return func(job Job) Job {
+		guard := newOverlapGuard(OverlapQueue, 1, 0)
+		return func(ctx context.Context) error {
+			queued := time.Now()
+			_, t := guard.admit(func() {})
+			defer guard.release(t)
+			guard.await(t)
+			if dur := time.Since(queued); dur > time.Minute {
+				run, _ := RunInfoFromContext(ctx)
+				logger.Info("job execution delayed", "event", "delay", "id", run.ID, "name", run.Name, "duration", dur)
+			}
+			return job(ctx)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recordingWrapper returns a wrapper appending its name to calls around the
+// job.
+func recordingWrapper(mu *sync.Mutex, calls *[]string, name string) JobWrapper {
+	return func(job Job) Job {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			*calls = append(*calls, name)
+			mu.Unlock()
+			return job(ctx)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	job := Chain(
+		recordingWrapper(&mu, &calls, "a"),
+		recordingWrapper(&mu, &calls, "b"),
+	)(func(context.Context) error {
+		calls = append(calls, "job")
+		return nil
+	})
+	if err := job(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b", "job"}; !slices.Equal(calls, want) {
+		t.Errorf("expected calls %v, got %v", want, calls)
+	}
+}
+
+// The wrappers of the Cron wrap those of the entry, and see the context of
+// the run.
+func TestWithChain(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	var scheduled bool
+	cron := New(
+		WithChain(recordingWrapper(&mu, &calls, "cron 1")),
+		WithChain(recordingWrapper(&mu, &calls, "cron 2")),
+	)
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ran := make(chan struct{})
+	id, err := cron.Schedule(sched, func() { close(ran) }, EntryChain(
+		recordingWrapper(&mu, &calls, "entry 1"),
+		func(job Job) Job {
+			return func(ctx context.Context) error {
+				_, scheduled = RunInfoFromContext(ctx)
+				return job(ctx)
+			}
+		},
+		recordingWrapper(&mu, &calls, "entry 2"),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	<-ran
+	<-cron.Stop().Done()
+
+	if want := []string{"cron 1", "cron 2", "entry 1", "entry 2"}; !slices.Equal(calls, want) {
+		t.Errorf("expected calls %v, got %v", want, calls)
+	}
+	if !scheduled {
+		t.Error("expected the run described by the context of the wrappers")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	errBoom := errors.New("boom")
+	cases := []struct {
+		name  string
+		value any
+		is    error
+	}{
+		{"error", errBoom, errBoom},
+		{"value", "boom", nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			job := Recover()(func(context.Context) error { panic(tc.value) })
+			err := job(context.Background())
+			if err == nil || !strings.Contains(err.Error(), "boom") {
+				t.Fatalf("expected the panic as an error, got %v", err)
+			}
+			if tc.is != nil && !errors.Is(err, tc.is) {
+				t.Errorf("expected the error panicked with wrapped, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	job := Timeout(10 * time.Millisecond)(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err := job(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline exceeded, got %v", err)
+	}
+}
+
+// overlappingCalls calls the job wrapped by wrapper twice, the second call
+// while the first is in progress, returning how many times the job ran and
+// how many calls were in progress at once at most.
+func overlappingCalls(t *testing.T, wrapper JobWrapper) (runs, concurrent int32) {
+	t.Helper()
+	var running, most, count atomic.Int32
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	job := wrapper(func(context.Context) error {
+		count.Add(1)
+		n := running.Add(1)
+		for {
+			m := most.Load()
+			if n <= m || most.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		started <- struct{}{}
+		<-release
+		running.Add(-1)
+		return nil
+	})
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		job(context.Background())
+	}()
+	<-started
+	go func() {
+		defer wg.Done()
+		job(context.Background())
+	}()
+	// Give the second call the time to be skipped or to wait.
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	return count.Load(), most.Load()
+}
+
+func TestSkipIfStillRunning(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	if runs, _ := overlappingCalls(t, SkipIfStillRunning(logger)); runs != 1 {
+		t.Errorf("expected the overlapping call skipped, got %d runs", runs)
+	}
+}
+
+func TestDelayIfStillRunning(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	runs, concurrent := overlappingCalls(t, DelayIfStillRunning(logger))
+	if runs != 2 || concurrent != 1 {
+		t.Errorf("expected the overlapping call delayed, got %d runs, %d at once", runs, concurrent)
+	}
+}
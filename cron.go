@@ -1,23 +1,67 @@
 package cron
 
 import (
+	"cmp"
 	"container/heap"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"runtime"
+	"maps"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type insertion struct {
 	entry *Entry
-	done  chan struct{}
+	done  chan Entry
 }
 
 type removal struct {
+	match func(*Entry) bool
+	done  chan []*Entry
+}
+
+type suspension struct {
+	match  func(*Entry) bool
+	paused bool
+	done   chan int
+}
+
+type rescheduling struct {
+	id       ID
+	schedule Schedule
+	done     chan bool
+}
+
+type triggering struct {
 	id   ID
-	done chan struct{}
+	done chan triggered
+}
+
+type triggered struct {
+	dispatch Dispatch
+	found    bool
+}
+
+var (
+	// ErrEntryNotFound is returned when the given ID matches no entry.
+	ErrEntryNotFound = errors.New("entry not found")
+	// ErrNotRunning is returned when an operation requires the scheduler to be
+	// running.
+	ErrNotRunning = errors.New("cron not running")
+)
+
+// DuplicateNameError is returned when scheduling an entry with the name of
+// another entry of the Cron.
+type DuplicateNameError struct {
+	Name string
+}
+
+func (e *DuplicateNameError) Error() string {
+	return fmt.Sprintf("duplicate entry name %q", e.Name)
 }
 
 // Cron keeps track of any number of entries, invoking the associated func as
@@ -25,16 +69,41 @@ type removal struct {
 // be inspected while running.
 type Cron struct {
 	entries          entryHeap
-	overlap          func(func(), *slog.Logger) func()
+	overlap          OverlapPolicy
+	maxRuns          int
+	maxQueued        int
+	misfire          Misfire
+	restart          RestartPolicy
+	retry            RetryPolicy
+	runTimeout       RunTimeout
+	panicPolicy      PanicPolicy
+	retries          []pendingRetry
+	retrying         chan pendingRetry
+	lagThreshold     time.Duration
+	store            Store
+	hooks            hookList
+	chain            []JobWrapper
 	stop             chan struct{}
+	cancel           context.CancelFunc
+	halted           chan struct{}
 	add              chan insertion
 	remove           chan removal
+	suspend          chan suspension
+	reschedule       chan rescheduling
+	trigger          chan triggering
 	snapshot         chan chan []Entry
 	running          bool
 	logger           *slog.Logger
 	runningMu        sync.Mutex
 	next             ID
+	names            map[string]ID
+	statuses         map[ID]*entryStatus
+	historySize      int
 	jobWaiter        sync.WaitGroup
+	inflight         runTracker
+	shutdownGrace    time.Duration
+	abandoned        atomic.Int64
+	pool             *workerPool
 	clock            Clock
 	onCycleCompleted []func()
 }
@@ -48,34 +117,241 @@ type Entry struct {
 	// snapshot or remove it.
 	ID ID
 
+	// Name identifies the entry in a stable way, unlike ID: in logs, across
+	// restarts of the process and for lookups. It is unique within the Cron,
+	// and empty if the entry was given none, see [EntryName].
+	Name string
+
+	// Description tells what the job does, for people inspecting the entries.
+	Description string
+
+	// Labels group the entry with others, for operating on all of them at once,
+	// see [Selector].
+	Labels map[string]string
+
 	// Schedule on which this job should be run.
 	Schedule Schedule
 
 	// Next time the job will run, or the zero time if Cron has not been
-	// started or this entry's schedule is unsatisfiable
+	// started, this entry is paused or its schedule is unsatisfiable
 	Next time.Time
 
 	// Prev is the last time this job was run, or the zero time if never.
 	Prev time.Time
 
-	job    func()
+	// Paused reports whether the entry is suspended, see [Cron.Pause].
+	Paused bool
+
+	// Priority orders the activations of this job against the others: among
+	// entries coming due at the same wake-up, and waiting for a worker, higher
+	// priorities go first. It defaults to zero.
+	Priority int
+
+	// Misfire is the handling in effect for activations of this job missed by
+	// the scheduler: the one given to the entry, or else the Cron's.
+	Misfire Misfire
+
+	// RunTimeout bounds the runs of this job: the one given to the entry, or
+	// else the Cron's.
+	RunTimeout RunTimeout
+
+	// PanicPolicy is the handling in effect for the runs of this job that
+	// panicked: the one given to the entry, or else the Cron's.
+	PanicPolicy PanicPolicy
+
+	// Retry is the policy in effect for retrying the failed runs of this job:
+	// the one given to the entry, or else the Cron's.
+	Retry RetryPolicy
+
+	// Overlap is the policy in effect for runs of this job overlapping each
+	// other: the one given to the entry, or else the Cron's.
+	Overlap OverlapPolicy
+
+	// MaxConcurrentRuns is the number of runs of this job allowed in progress
+	// at once before the overlap policy applies, zero meaning no limit.
+	MaxConcurrentRuns int
+
+	// MaxQueuedRuns bounds the activations of this job waiting for a run in
+	// progress to complete, zero meaning no bound.
+	MaxQueuedRuns int
+
+	// LastScheduled is the activation time of the last completed run of the
+	// job, or the zero time if it was triggered.
+	LastScheduled time.Time
+
+	// LastStart is the time the last completed run of the job started, or the
+	// zero time if none has completed yet.
+	LastStart time.Time
+
+	// LastLag is how late the last completed run of the job started after its
+	// activation time, see [RunInfo.Lag].
+	LastLag time.Duration
+
+	// LastEnd is the time the last completed run of the job returned.
+	LastEnd time.Time
+
+	// LastDuration is how long the last completed run of the job took.
+	LastDuration time.Duration
+
+	// LastWait is how long the last completed run of the job waited for a
+	// worker, see [WithWorkerPool].
+	LastWait time.Duration
+
+	// LastError is the error returned by the last completed run of the job, or
+	// the panic it recovered from; nil if the run succeeded.
+	LastError error
+
+	// ConsecutiveFailures counts the runs of the job that failed in a row, up to
+	// the last completed one; zero if that one succeeded.
+	ConsecutiveFailures int
+
+	job    Job
+	chain  []JobWrapper
 	logger *slog.Logger
+	guard  *overlapGuard
+	status *entryStatus
+	// restored tells that Prev was loaded from the store, and the activations
+	// following it not computed yet.
+	restored bool
+	// removed tells that the entry has been removed, so that the retries of its
+	// runs still on their way to the scheduler are dropped.
+	removed bool
+}
+
+// entryStatus holds the outcome of the last completed runs of an entry. It is
+// written by the goroutines running the job, hence guarded by its own mutex
+// rather than owned by the scheduler goroutine like the rest of the entry.
+type entryStatus struct {
+	mu sync.Mutex
+	// saveMu orders the saves of the outcomes to the store, without holding mu
+	// and so the scheduler goroutine back on a slow store.
+	saveMu    sync.Mutex
+	scheduled time.Time
+	start     time.Time
+	end       time.Time
+	wait      time.Duration
+	err       error
+	failures  int
+	panics    int
+	// attempt is the attempt number of the last completed run, whose lag
+	// is only meaningful for a first attempt.
+	attempt int
+	// prev is the latest activation time of the completed scheduled runs.
+	prev        time.Time
+	history     runHistory
+	historySize int
+}
+
+// record stores the outcome of a completed run, which failed with err if not
+// nil. The caller must hold mu.
+func (s *entryStatus) record(run RunRecord, wait time.Duration, err error) {
+	s.history.add(s.historySize, run)
+	s.scheduled = run.Scheduled
+	s.attempt = run.Attempt
+	s.start = run.Start
+	s.end = run.End
+	s.wait = wait
+	s.err = err
+	if err != nil {
+		s.failures++
+	} else {
+		s.failures = 0
+	}
+	if run.Outcome == OutcomePanic {
+		s.panics++
+	} else {
+		s.panics = 0
+	}
+	if run.Scheduled.After(s.prev) {
+		s.prev = run.Scheduled
+	}
+}
+
+// state returns the state of the entry to persist. The caller must hold mu.
+func (s *entryStatus) state() EntryState {
+	state := EntryState{
+		Prev:                s.prev,
+		LastStart:           s.start,
+		LastEnd:             s.end,
+		ConsecutiveFailures: s.failures,
+	}
+	if s.err != nil {
+		state.LastError = s.err.Error()
+	}
+	return state
+}
+
+// restore sets the entry as saved in state.
+func (e *Entry) restore(state EntryState) {
+	e.Prev = state.Prev
+	e.restored = !state.Prev.IsZero()
+	e.status.prev = state.Prev
+	e.status.start = state.LastStart
+	e.status.end = state.LastEnd
+	e.status.failures = state.ConsecutiveFailures
+	if state.LastError != "" {
+		e.status.err = errors.New(state.LastError)
+	}
+}
+
+// runInfo describes the run of e for the activation at scheduled.
+func (e *Entry) runInfo(scheduled time.Time) RunInfo {
+	return RunInfo{ID: e.ID, Name: e.Name, Scheduled: scheduled, Attempt: 1}
+}
+
+// firstNext returns the first activation of e once scheduled at now. For an
+// entry restored from the store, it is the one following its Prev time, so
+// that the activations missed while the process was down are handled by the
+// misfire policy.
+func (e *Entry) firstNext(now time.Time) time.Time {
+	if e.restored {
+		e.restored = false
+		return e.Schedule.Next(e.Prev)
+	}
+	return e.Schedule.Next(now)
+}
+
+// snapshot returns a copy of the entry, including the outcome of its last
+// completed run.
+func (e *Entry) snapshot() Entry {
+	entry := *e
+	entry.Labels = maps.Clone(e.Labels)
+	e.status.mu.Lock()
+	defer e.status.mu.Unlock()
+	entry.LastScheduled = e.status.scheduled
+	entry.LastStart = e.status.start
+	entry.LastLag = RunInfo{Scheduled: e.status.scheduled, Start: e.status.start, Attempt: e.status.attempt}.Lag()
+	entry.LastEnd = e.status.end
+	entry.LastDuration = e.status.end.Sub(e.status.start)
+	entry.LastWait = e.status.wait
+	entry.LastError = e.status.err
+	entry.ConsecutiveFailures = e.status.failures
+	return entry
 }
 
 // New returns a new Cron job runner, modified by the given options.
 func New(opts ...Option) *Cron {
 	c := &Cron{
 		entries:          entryHeap{},
-		overlap:          func(cmd func(), logger *slog.Logger) func() { return cmd },
+		overlap:          OverlapAllow,
 		add:              make(chan insertion),
 		stop:             make(chan struct{}),
 		snapshot:         make(chan chan []Entry),
 		remove:           make(chan removal),
+		suspend:          make(chan suspension),
+		reschedule:       make(chan rescheduling),
+		trigger:          make(chan triggering),
+		retrying:         make(chan pendingRetry),
 		running:          false,
 		runningMu:        sync.Mutex{},
 		logger:           slog.Default(),
 		next:             1,
+		names:            map[string]ID{},
+		statuses:         map[ID]*entryStatus{},
+		historySize:      DefaultHistorySize,
+		shutdownGrace:    DefaultShutdownGrace,
 		clock:            NewDefaultClock(time.Local, DefaultNopTimer),
+		pool:             &workerPool{},
 		onCycleCompleted: []func(){},
 	}
 	for _, opt := range opts {
@@ -84,31 +360,88 @@ func New(opts ...Option) *Cron {
 	return c
 }
 
-// Schedule adds a job to the Cron to be run on the given schedule.
-func (c *Cron) Schedule(schedule Schedule, cmd func()) (ID, error) {
+// Schedule adds a job to the Cron to be run on the given schedule, modified by
+// the given entry options.
+func (c *Cron) Schedule(schedule Schedule, cmd func(), opts ...EntryOption) (ID, error) {
+	return c.ScheduleContext(schedule, func(context.Context) error {
+		cmd()
+		return nil
+	}, opts...)
+}
+
+// ScheduleContext adds a context-aware job to the Cron to be run on the given
+// schedule.
+//
+// Each run receives a context that is cancelled when the Cron is stopped, so
+// that long-running jobs can abort cleanly, and that describes the run, see
+// [RunInfoFromContext]. A non-nil error returned by the job is logged.
+//
+// The job is wrapped by the wrappers of the entry, then by those of the Cron,
+// see [WithChain] and [EntryChain].
+func (c *Cron) ScheduleContext(schedule Schedule, job Job, opts ...EntryOption) (ID, error) {
+	entry, err := c.insert(schedule, job, opts...)
+	if err != nil {
+		return 0, err
+	}
+	c.hooks.OnScheduled(entry)
+	return entry.ID, nil
+}
+
+// insert adds a new entry for the job, returning a snapshot of it as added.
+func (c *Cron) insert(schedule Schedule, job Job, opts ...EntryOption) (Entry, error) {
 	c.runningMu.Lock()
 	defer c.runningMu.Unlock()
 
 	if c.next == 0 {
-		return 0, fmt.Errorf("run out of available ids")
+		return Entry{}, fmt.Errorf("run out of available ids")
 	}
 
 	logger := c.logger.With("id", c.next)
 	entry := &Entry{
-		ID:       c.next,
-		Schedule: schedule,
-		job:      c.overlap(cmd, logger),
-		logger:   logger,
+		ID:                c.next,
+		Schedule:          schedule,
+		Misfire:           c.misfire,
+		Retry:             c.retry,
+		RunTimeout:        c.runTimeout,
+		PanicPolicy:       c.panicPolicy,
+		Overlap:           c.overlap,
+		MaxConcurrentRuns: c.maxRuns,
+		MaxQueuedRuns:     c.maxQueued,
+		logger:            logger,
+		status:            &entryStatus{historySize: c.historySize},
+	}
+	for _, opt := range opts {
+		opt(entry)
+	}
+	entry.job = Chain(c.chain...)(Chain(entry.chain...)(job))
+	if entry.Name != "" {
+		if _, ok := c.names[entry.Name]; ok {
+			return Entry{}, &DuplicateNameError{Name: entry.Name}
+		}
+		entry.logger = entry.logger.With("name", entry.Name)
+	}
+	if c.store != nil && entry.Name != "" {
+		state, ok, err := c.store.Load(entry.Name)
+		if err != nil {
+			return Entry{}, fmt.Errorf("loading state of entry %q: %w", entry.Name, err)
+		}
+		if ok {
+			entry.restore(state)
+		}
+	}
+	entry.guard = newOverlapGuard(entry.Overlap, entry.MaxConcurrentRuns, entry.MaxQueuedRuns)
+	if entry.Name != "" {
+		c.names[entry.Name] = entry.ID
 	}
+	c.statuses[entry.ID] = entry.status
 	c.next++
 	if !c.running {
 		c.entries = append(c.entries, entry)
-	} else {
-		done := make(chan struct{})
-		c.add <- insertion{entry: entry, done: done}
-		<-done
+		return entry.snapshot(), nil
 	}
-	return entry.ID, nil
+	done := make(chan Entry)
+	c.add <- insertion{entry: entry, done: done}
+	return <-done, nil
 }
 
 // Entries returns a snapshot of the cron entries.
@@ -123,6 +456,17 @@ func (c *Cron) Entries() []Entry {
 	return c.entrySnapshot()
 }
 
+// EntriesMatching returns a snapshot of the entries matched by the selector.
+func (c *Cron) EntriesMatching(selector Selector) []Entry {
+	var entries []Entry
+	for _, entry := range c.Entries() {
+		if selector.Matches(entry.Labels) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
 // Entry returns a snapshot of the given entry, or nil if it couldn't be found.
 func (c *Cron) Entry(id ID) Entry {
 	for _, entry := range c.Entries() {
@@ -133,20 +477,209 @@ func (c *Cron) Entry(id ID) Entry {
 	return Entry{}
 }
 
+// EntryByName returns a snapshot of the entry with the given name, or the zero
+// Entry if it couldn't be found.
+func (c *Cron) EntryByName(name string) Entry {
+	id, ok := c.lookup(name)
+	if !ok {
+		return Entry{}
+	}
+	return c.Entry(id)
+}
+
+// lookup returns the ID of the entry with the given name.
+func (c *Cron) lookup(name string) (ID, bool) {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+	id, ok := c.names[name]
+	return id, ok
+}
+
+// History returns the last completed runs of the given entry, oldest first, up
+// to the size set by [WithHistorySize]. Runs still in progress are not part of
+// it.
+func (c *Cron) History(id ID) ([]RunRecord, error) {
+	c.runningMu.Lock()
+	status, ok := c.statuses[id]
+	c.runningMu.Unlock()
+	if !ok {
+		return nil, ErrEntryNotFound
+	}
+	status.mu.Lock()
+	defer status.mu.Unlock()
+	return status.history.list(), nil
+}
+
 // Remove an entry from being run in the future.
 func (c *Cron) Remove(id ID) {
+	c.removeMatching(byID(id))
+}
+
+// RemoveMatching removes the entries matched by the selector from being run in
+// the future, returning their IDs. The entries are matched and removed at once
+// by the scheduler, so that none of them comes due in between.
+func (c *Cron) RemoveMatching(selector Selector) []ID {
+	var ids []ID
+	for _, e := range c.removeMatching(selector.matches) {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+// removeMatching removes the matched entries, returning them. The OnRemoved
+// hooks are called once the lock is released.
+func (c *Cron) removeMatching(match func(*Entry) bool) []*Entry {
+	removed := c.removeLocked(match)
+	for _, e := range removed {
+		c.hooks.OnRemoved(e.snapshot())
+	}
+	return removed
+}
+
+// removeLocked removes the matched entries under runningMu, returning them.
+func (c *Cron) removeLocked(match func(*Entry) bool) []*Entry {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+	var removed []*Entry
+	if c.running {
+		done := make(chan []*Entry)
+		c.remove <- removal{match: match, done: done}
+		removed = <-done
+	} else {
+		removed = c.removeEntries(match)
+	}
+	for _, e := range removed {
+		if e.Name != "" {
+			delete(c.names, e.Name)
+		}
+		delete(c.statuses, e.ID)
+	}
+	return removed
+}
+
+// RemoveByName removes the entry with the given name from being run in the
+// future, freeing the name.
+func (c *Cron) RemoveByName(name string) error {
+	id, ok := c.lookup(name)
+	if !ok {
+		return ErrEntryNotFound
+	}
+	c.Remove(id)
+	return nil
+}
+
+// Pause suspends an entry: it stays registered with its ID, Prev time and
+// overlap guard, but is not run until resumed. Runs already started are not
+// affected. Pausing an entry already paused does nothing.
+func (c *Cron) Pause(id ID) error {
+	return c.setPaused(id, true)
+}
+
+// Resume brings back an entry suspended by [Cron.Pause], computing its next
+// activation from the current time. Resuming an entry not paused does nothing.
+func (c *Cron) Resume(id ID) error {
+	return c.setPaused(id, false)
+}
+
+// PauseMatching pauses the entries matched by the selector like [Cron.Pause],
+// returning how many matched. The entries are matched and paused at once by the
+// scheduler, so that none of them comes due in between.
+func (c *Cron) PauseMatching(selector Selector) int {
+	return c.setPausedMatching(selector.matches, true)
+}
+
+// ResumeMatching resumes the entries matched by the selector like
+// [Cron.Resume], returning how many matched.
+func (c *Cron) ResumeMatching(selector Selector) int {
+	return c.setPausedMatching(selector.matches, false)
+}
+
+func (c *Cron) setPaused(id ID, paused bool) error {
+	if c.setPausedMatching(byID(id), paused) == 0 {
+		return ErrEntryNotFound
+	}
+	return nil
+}
+
+func (c *Cron) setPausedMatching(match func(*Entry) bool, paused bool) int {
 	c.runningMu.Lock()
 	defer c.runningMu.Unlock()
 	if c.running {
-		done := make(chan struct{})
-		c.remove <- removal{id: id, done: done}
-		<-done
+		done := make(chan int)
+		c.suspend <- suspension{match: match, paused: paused, done: done}
+		return <-done
+	}
+	return c.suspendEntries(match, paused, c.clock.Now())
+}
+
+// Reschedule replaces the schedule of an entry, computing its next activation
+// from the current time. Everything else about the entry is preserved: its ID,
+// Prev time, paused state and overlap guard, so that runs in progress keep
+// holding back the overlapping ones.
+func (c *Cron) Reschedule(id ID, schedule Schedule) error {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+	var found bool
+	if c.running {
+		done := make(chan bool)
+		c.reschedule <- rescheduling{id: id, schedule: schedule, done: done}
+		found = <-done
 	} else {
-		c.removeEntry(id)
+		found = c.rescheduleEntry(id, schedule, c.clock.Now())
+	}
+	if !found {
+		return ErrEntryNotFound
+	}
+	return nil
+}
+
+// Trigger runs an entry right away, outside of its schedule, leaving its Next
+// and Prev times untouched. The run is subject to the overlap policy like any
+// other activation, and the returned Dispatch tells whether it was started,
+// queued, skipped or replaced the run in progress. Triggered runs are not part
+// of any activation cycle, so they do not complete one (see
+// [WithOnCycleCompleted]).
+//
+// Entries can only be triggered while the scheduler is running; paused ones
+// can be triggered too.
+func (c *Cron) Trigger(id ID) (Dispatch, error) {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+	if !c.running {
+		return DispatchSkipped, ErrNotRunning
+	}
+	done := make(chan triggered)
+	c.trigger <- triggering{id: id, done: done}
+	result := <-done
+	if !result.found {
+		return DispatchSkipped, ErrEntryNotFound
 	}
+	return result.dispatch, nil
+}
+
+// TriggerByName runs the entry with the given name right away, like
+// [Cron.Trigger].
+func (c *Cron) TriggerByName(name string) (Dispatch, error) {
+	id, ok := c.lookup(name)
+	if !ok {
+		return DispatchSkipped, ErrEntryNotFound
+	}
+	return c.Trigger(id)
+}
+
+// Stats reports the load of the jobs on the worker pool, see [WithWorkerPool].
+func (c *Cron) Stats() Stats {
+	s := c.pool.stats(c.clock.Now())
+	s.Abandoned = int(c.abandoned.Load())
+	return s
 }
 
 // Start the cron scheduler in its own goroutine, or no-op if already started.
+//
+// A scheduler stopped may be started again, the entries keeping their Prev
+// times, paused states, overlap guards and pending retries. The activations
+// missed in between are handled according to the restart policy, see
+// [WithRestartPolicy].
 func (c *Cron) Start() {
 	c.runningMu.Lock()
 	defer c.runningMu.Unlock()
@@ -154,7 +687,7 @@ func (c *Cron) Start() {
 		return
 	}
 	c.running = true
-	go c.run()
+	go c.run(c.newRootContext())
 }
 
 // Run the cron scheduler, or no-op if already running.
@@ -165,19 +698,38 @@ func (c *Cron) Run() {
 		return
 	}
 	c.running = true
+	ctx := c.newRootContext()
 	c.runningMu.Unlock()
-	c.run()
+	c.run(ctx)
+}
+
+// newRootContext creates the context the jobs started until the next Stop are
+// given, recording its cancel func for Stop, along with the channel closed
+// once the scheduler is halted. The caller must hold runningMu.
+func (c *Cron) newRootContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.halted = make(chan struct{})
+	return ctx
 }
 
 // run the scheduler.. this is private just due to the need to synchronize
 // access to the 'running' state variable.
-func (c *Cron) run() {
+func (c *Cron) run(ctx context.Context) {
 	c.logger.Info("starting scheduler", "event", "start")
 
 	// Figure out the next activation times for each entry.
 	now := c.clock.Now()
+	c.hooks.OnSchedulerStart(now)
 	for _, entry := range c.entries {
-		entry.Next = entry.Schedule.Next(now)
+		if entry.Paused {
+			continue
+		}
+		if c.restart.resumes(entry) {
+			entry.logger.Debug("next execution time kept", "event", "next", "now", now, "next", entry.Next)
+			continue
+		}
+		entry.Next = entry.firstNext(now)
 		entry.logger.Debug("next execution time computed", "event", "next", "now", now, "next", entry.Next)
 	}
 	heap.Init(&c.entries)
@@ -185,12 +737,12 @@ func (c *Cron) run() {
 	for {
 		var timer <-chan struct{}
 		var stop func()
-		if len(c.entries) == 0 || c.entries[0].Next.IsZero() {
+		if next := c.nextWakeUp(); next.IsZero() {
 			// If there are no entries yet, just sleep - it still handles new entries
 			// and stop requests.
 			timer, stop = c.clock.NopTimer()
 		} else {
-			timer, stop = c.clock.Timer(c.entries[0].Next)
+			timer, stop = c.clock.Timer(next)
 		}
 
 		for {
@@ -200,17 +752,40 @@ func (c *Cron) run() {
 				c.logger.Debug("scheduler woke up", "event", "wake", "now", now)
 				cycleGroup := &sync.WaitGroup{}
 
-				// Run every entry whose next time was less than now
-				for {
-					if c.entries[0].Next.After(now) || c.entries[0].Next.IsZero() {
-						break
+				// Run every entry whose next time was less than now, the ones with
+				// a higher priority first.
+				var due []*Entry
+				for len(c.entries) > 0 && !c.entries[0].Next.After(now) && !c.entries[0].Next.IsZero() {
+					due = append(due, heap.Pop(&c.entries).(*Entry))
+				}
+				slices.SortStableFunc(due, func(a, b *Entry) int {
+					return cmp.Compare(b.Priority, a.Priority)
+				})
+				for _, e := range due {
+					missed, onTime := e.due(now)
+					for _, t := range missed {
+						e.logger.Warn("missed job execution", "event", "misfire", "now", now, "scheduled", t, "policy", e.Misfire.Policy)
+						c.hooks.OnMisfire(e.runInfo(t))
 					}
-					e := heap.Pop(&c.entries).(*Entry)
-					c.startJob(e, cycleGroup)
-					e.Prev = e.Next
 					e.Next = e.Schedule.Next(now)
+					for _, t := range e.Misfire.runs(missed, onTime) {
+						c.startJob(ctx, e, e.runInfo(t), cycleGroup)
+						e.Prev = t
+						e.logger.Info("starting job", "event", "run", "now", now, "scheduled", t, "lag", now.Sub(t), "next", e.Next)
+					}
 					heap.Push(&c.entries, e)
-					e.logger.Info("starting job", "event", "run", "now", now, "next", e.Next)
+				}
+
+				// Then every retry whose time has come.
+				for len(c.retries) > 0 && !c.retries[0].at.After(now) {
+					retry := c.retries[0]
+					c.retries = c.retries[1:]
+					if retry.entry.Paused {
+						retry.entry.logger.Info("job execution retry dropped", "event", "retry", "attempt", retry.run.Attempt, "reason", "paused")
+						continue
+					}
+					retry.entry.logger.Info("retrying job", "event", "retry", "now", now, "scheduled", retry.run.Scheduled, "attempt", retry.run.Attempt)
+					c.startJob(ctx, retry.entry, retry.run, cycleGroup)
 				}
 				go func() {
 					cycleGroup.Wait()
@@ -223,10 +798,10 @@ func (c *Cron) run() {
 				stop()
 				now = c.clock.Now()
 				entry := insertion.entry
-				entry.Next = entry.Schedule.Next(now)
+				entry.Next = entry.firstNext(now)
 				heap.Push(&c.entries, entry)
 				entry.logger.Info("added new entry", "event", "add", "now", now, "next", entry.Next)
-				insertion.done <- struct{}{}
+				insertion.done <- entry.snapshot()
 
 			case replyChan := <-c.snapshot:
 				replyChan <- c.entrySnapshot()
@@ -239,8 +814,27 @@ func (c *Cron) run() {
 
 			case removal := <-c.remove:
 				stop()
-				c.removeEntry(removal.id)
-				removal.done <- struct{}{}
+				removal.done <- c.removeEntries(removal.match)
+
+			case suspension := <-c.suspend:
+				stop()
+				now = c.clock.Now()
+				suspension.done <- c.suspendEntries(suspension.match, suspension.paused, now)
+
+			case rescheduling := <-c.reschedule:
+				stop()
+				now = c.clock.Now()
+				rescheduling.done <- c.rescheduleEntry(rescheduling.id, rescheduling.schedule, now)
+
+			case triggering := <-c.trigger:
+				stop()
+				triggering.done <- c.triggerEntry(ctx, triggering.id)
+
+			case retry := <-c.retrying:
+				stop()
+				if !retry.entry.removed {
+					c.queueRetry(retry)
+				}
 			}
 
 			break
@@ -248,38 +842,244 @@ func (c *Cron) run() {
 	}
 }
 
-// startJob runs the given job in a new goroutine.
-func (c *Cron) startJob(entry *Entry, cycleGroup *sync.WaitGroup) {
+// nextWakeUp returns the time the scheduler is next due to wake up at, for an
+// entry or a retry, or the zero time if none is due.
+func (c *Cron) nextWakeUp() time.Time {
+	var next time.Time
+	if len(c.entries) > 0 {
+		next = c.entries[0].Next
+	}
+	if len(c.retries) > 0 && (next.IsZero() || c.retries[0].at.Before(next)) {
+		next = c.retries[0].at
+	}
+	return next
+}
+
+// queueRetry queues the given retry among the others, by time.
+func (c *Cron) queueRetry(retry pendingRetry) {
+	i, _ := slices.BinarySearchFunc(c.retries, retry.at, func(r pendingRetry, at time.Time) int {
+		if r.at.After(at) {
+			return 1
+		}
+		return -1
+	})
+	c.retries = slices.Insert(c.retries, i, retry)
+}
+
+// startJob hands the given run of the entry over to the overlap policy of the
+// entry, running the job in a new goroutine, passing it ctx, unless skipped.
+// cycleGroup, if not nil, keeps track of the runs started in the same instant.
+func (c *Cron) startJob(ctx context.Context, entry *Entry, run RunInfo, cycleGroup *sync.WaitGroup) Dispatch {
+	ctx, cancel := context.WithCancel(ctx)
+	dispatch, ticket := entry.guard.admit(cancel)
+	if dispatch == DispatchSkipped {
+		cancel()
+		entry.logger.Info("job execution skipped", "event", "skip")
+		c.hooks.OnSkip(run)
+		return dispatch
+	}
+	if dispatch == DispatchReplaced {
+		entry.logger.Info("job execution replacing the running one", "event", "replace")
+	}
+	// An activation started right away asks for a worker at once, so that it
+	// gets one in the order it came due; the others do once they may start.
+	var worker <-chan struct{}
+	working := dispatch == DispatchStarted
+	if working {
+		worker = c.pool.acquire(c.clock.Now(), entry.Priority)
+	}
 	c.jobWaiter.Add(1)
-	cycleGroup.Add(1)
+	c.inflight.add(entry, 1)
+	if cycleGroup != nil {
+		cycleGroup.Add(1)
+	}
 	go func() {
 		defer func() {
-			if r := recover(); r != nil {
-				const size = 64 << 10
-				buf := make([]byte, size)
-				buf = buf[:runtime.Stack(buf, false)]
-				err, ok := r.(error)
-				if !ok {
-					err = fmt.Errorf("%v", r)
-				}
-				entry.logger.Error(err.Error(), "event", "panic", "stack", "...\n"+string(buf))
+			cancel()
+			c.inflight.add(entry, -1)
+			if working {
+				c.pool.release()
+			}
+			entry.guard.release(ticket)
+			if cycleGroup != nil {
+				cycleGroup.Done()
 			}
-			cycleGroup.Done()
 			c.jobWaiter.Done()
 		}()
-		entry.job()
+		queued := c.clock.Now()
+		if entry.guard.await(ticket) {
+			entry.logger.Warn("replaced job execution did not return in time", "event", "replace", "timeout", entry.guard.timeout)
+		}
+		switch dispatch {
+		case DispatchQueued:
+			dur := c.clock.Now().Sub(queued)
+			if dur > time.Minute {
+				entry.logger.Info("job execution delayed", "event", "delay", "duration", dur)
+			}
+			c.hooks.OnDelay(run, dur)
+		case DispatchReplaced:
+			if ctx.Err() != nil {
+				// Replaced in turn by a later activation before it could start.
+				entry.logger.Info("job execution skipped", "event", "skip")
+				c.hooks.OnSkip(run)
+				return
+			}
+		}
+		if !working {
+			worker = c.pool.acquire(c.clock.Now(), entry.Priority)
+			working = true
+		}
+		waiting := c.clock.Now()
+		if worker != nil {
+			select {
+			case <-worker:
+			case <-ctx.Done():
+				// Stopped or replaced before getting a worker: the activation is
+				// not run at all.
+				if c.pool.cancel(worker) {
+					working = false
+				}
+				entry.logger.Info("job execution skipped", "event", "skip", "reason", "cancelled")
+				c.hooks.OnSkip(run)
+				return
+			}
+		}
+		c.runJob(ctx, entry, run, c.clock.Now().Sub(waiting))
 	}()
+	return dispatch
+}
+
+// runJob performs the given run of the job of entry, bounded by its timeout,
+// logging its failure if any, and recording its outcome on the entry along
+// with how long it waited for a worker.
+func (c *Cron) runJob(ctx context.Context, entry *Entry, run RunInfo, wait time.Duration) {
+	run.Start = c.clock.Now()
+	if lag := run.Lag(); c.lagThreshold > 0 && lag > c.lagThreshold {
+		entry.logger.Warn("job execution started late", "event", "lag", "scheduled", run.Scheduled, "start", run.Start, "lag", lag)
+	}
+	c.hooks.OnJobStart(run)
+
+	jobCtx := context.WithValue(ctx, runInfoKey{}, run)
+	limit := entry.RunTimeout
+	if limit.Timeout > 0 {
+		var cancel context.CancelFunc
+		jobCtx, cancel = context.WithTimeout(jobCtx, limit.Timeout)
+		defer cancel()
+	}
+	var result jobResult
+	if limit.AbandonAfter > 0 {
+		result = c.awaitJob(jobCtx, entry, limit.AbandonAfter)
+	} else {
+		result = callJob(jobCtx, entry)
+	}
+
+	err := result.err
+	outcome := OutcomeSuccess
+	switch {
+	case result.panicked:
+		outcome = OutcomePanic
+		entry.logger.Error(err.Error(), "event", "panic", "stack", "...\n"+string(result.stack))
+		c.hooks.OnPanic(run, result.value, result.stack)
+	case errors.Is(jobCtx.Err(), context.DeadlineExceeded):
+		outcome = OutcomeTimeout
+		if err == nil {
+			err = ErrRunTimeout
+		} else {
+			err = fmt.Errorf("%w: %w", ErrRunTimeout, err)
+		}
+		entry.logger.Error(err.Error(), "event", "timeout", "timeout", limit.Timeout)
+	case err != nil:
+		outcome = OutcomeError
+		entry.logger.Error(err.Error(), "event", "error")
+	}
+	record := RunRecord{RunInfo: run, End: c.clock.Now(), Outcome: outcome}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	panics := c.record(entry, record, wait, err)
+	c.hooks.OnJobEnd(run, record.End.Sub(run.Start), err)
+	if result.panicked {
+		entry.PanicPolicy.handle(c, entry, run, result, panics)
+	}
+	if err != nil && ctx.Err() == nil && entry.Retry.retries(run.Attempt, err) {
+		c.retryJob(ctx, entry, record)
+	}
+}
+
+// retryJob hands a retry of the failed run of entry over to the scheduler, for
+// when the backoff of the retry policy has elapsed. It gives up if the
+// scheduler is stopped first, by [Cron.Stop] cancelling ctx or by
+// [Cron.Shutdown] halting it.
+func (c *Cron) retryJob(ctx context.Context, entry *Entry, failed RunRecord) {
+	c.runningMu.Lock()
+	halted := c.halted
+	c.runningMu.Unlock()
+
+	retry := pendingRetry{
+		entry: entry,
+		run:   RunInfo{ID: failed.ID, Name: failed.Name, Scheduled: failed.Scheduled, Attempt: failed.Attempt + 1},
+		at:    failed.End.Add(entry.Retry.backoff(failed.Attempt)),
+	}
+	entry.logger.Info("job execution retry scheduled", "event", "retry", "attempt", retry.run.Attempt, "at", retry.at)
+	select {
+	case c.retrying <- retry:
+	case <-ctx.Done():
+	case <-halted:
+		entry.logger.Info("job execution retry dropped", "event", "retry", "attempt", retry.run.Attempt, "reason", "stopped")
+	}
+}
+
+// record stores the outcome of a run of entry, saving the state of the entry to
+// the store if it has a name. The save lock is held from recording to saving,
+// so that the concurrent runs of an entry save their outcomes in the order they
+// recorded them. It returns how many runs of the entry panicked in a row.
+func (c *Cron) record(entry *Entry, run RunRecord, wait time.Duration, err error) (panics int) {
+	status := entry.status
+	status.saveMu.Lock()
+	defer status.saveMu.Unlock()
+	status.mu.Lock()
+	status.record(run, wait, err)
+	state, panics := status.state(), status.panics
+	status.mu.Unlock()
+	if c.store != nil && entry.Name != "" {
+		if err := c.store.Save(entry.Name, state); err != nil {
+			entry.logger.Error("saving entry state failed", "event", "store", "error", err)
+		}
+	}
+	return panics
 }
 
 // Stop stops the cron scheduler if it is running; otherwise it does nothing.
+// The context given to the running jobs is cancelled, asking them to return.
 // A context is returned so the caller can wait for running jobs to complete.
+//
+// The scheduler may be started again afterwards, see [Cron.Start]. See
+// [Cron.Shutdown] for letting the running jobs complete first.
 func (c *Cron) Stop() context.Context {
 	c.runningMu.Lock()
-	defer c.runningMu.Unlock()
-	if c.running {
-		c.stop <- struct{}{}
-		c.running = false
+	stopped := c.running
+	if stopped {
+		c.halt()
+		c.cancel()
+	}
+	c.runningMu.Unlock()
+	if stopped {
+		c.hooks.OnSchedulerStop(c.clock.Now())
 	}
+	return c.jobsDone()
+}
+
+// halt stops the scheduler, leaving the running jobs alone. The caller must
+// hold runningMu, the scheduler being running, and call the OnSchedulerStop
+// hooks once it is released.
+func (c *Cron) halt() {
+	c.stop <- struct{}{}
+	close(c.halted)
+	c.running = false
+}
+
+// jobsDone returns a context done once the running jobs have returned.
+func (c *Cron) jobsDone() context.Context {
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
 		c.jobWaiter.Wait()
@@ -292,17 +1092,94 @@ func (c *Cron) Stop() context.Context {
 func (c *Cron) entrySnapshot() []Entry {
 	var entries = make([]Entry, len(c.entries))
 	for i, e := range c.entries {
-		entries[i] = *e
+		entries[i] = e.snapshot()
 	}
 	return entries
 }
 
-func (c *Cron) removeEntry(id ID) {
+// byID matches the entry with the given ID.
+func byID(id ID) func(*Entry) bool {
+	return func(e *Entry) bool { return e.ID == id }
+}
+
+// removeEntries removes the matched entries, returning them.
+func (c *Cron) removeEntries(match func(*Entry) bool) []*Entry {
+	var removed []*Entry
+	kept := c.entries[:0]
+	for _, e := range c.entries {
+		if match(e) {
+			e.removed = true
+			e.logger.Info("removed entry", "event", "remove")
+			removed = append(removed, e)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+	clear(c.entries[len(kept):])
+	c.entries = kept
+	heap.Init(&c.entries)
+	c.retries = slices.DeleteFunc(c.retries, func(r pendingRetry) bool {
+		return slices.Contains(removed, r.entry)
+	})
+	return removed
+}
+
+// suspendEntries pauses or resumes the matched entries, returning how many
+// matched. A resumed entry gets its next activation computed from now.
+func (c *Cron) suspendEntries(match func(*Entry) bool, paused bool, now time.Time) int {
+	var matched int
+	for _, e := range c.entries {
+		if !match(e) {
+			continue
+		}
+		matched++
+		if e.Paused == paused {
+			continue
+		}
+		e.Paused = paused
+		if paused {
+			e.Next = time.Time{}
+			e.logger.Info("paused entry", "event", "pause")
+		} else {
+			e.Next = e.Schedule.Next(now)
+			// Count the panics afresh, for the panic policy to pause the entry
+			// again should it keep panicking.
+			e.status.mu.Lock()
+			e.status.panics = 0
+			e.status.mu.Unlock()
+			e.logger.Info("resumed entry", "event", "resume", "now", now, "next", e.Next)
+		}
+	}
+	heap.Init(&c.entries)
+	return matched
+}
+
+// rescheduleEntry swaps the schedule of the given entry, reporting whether it
+// exists. Unless paused, the entry gets its next activation computed from now.
+func (c *Cron) rescheduleEntry(id ID, schedule Schedule, now time.Time) bool {
 	for idx, e := range c.entries {
+		if e.ID != id {
+			continue
+		}
+		e.Schedule = schedule
+		if !e.Paused {
+			e.Next = schedule.Next(now)
+			heap.Fix(&c.entries, idx)
+		}
+		e.logger.Info("rescheduled entry", "event", "reschedule", "now", now, "next", e.Next)
+		return true
+	}
+	return false
+}
+
+// triggerEntry starts a run of the given entry out of its schedule.
+func (c *Cron) triggerEntry(ctx context.Context, id ID) triggered {
+	for _, e := range c.entries {
 		if e.ID == id {
-			e.logger.Info("removed entry", "event", "remove")
-			heap.Remove(&c.entries, idx)
-			return
+			dispatch := c.startJob(ctx, e, e.runInfo(time.Time{}), nil)
+			e.logger.Info("triggered job", "event", "trigger", "dispatch", dispatch)
+			return triggered{dispatch: dispatch, found: true}
 		}
 	}
+	return triggered{}
 }
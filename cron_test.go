@@ -3,6 +3,8 @@ package cron
 import (
 	"bytes"
 	"container/heap"
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -62,6 +64,133 @@ func TestPanicRecovery(t *testing.T) {
 	}
 }
 
+// A job scheduled with ScheduleContext returning an error gets it logged.
+func TestScheduleContextLogsError(t *testing.T) {
+	var buf syncWriter
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock), WithLogger(logger))
+	cron.Start()
+	defer cron.Stop()
+	sched, err := secondParser.Parse("* * * * * ?")
+	if err != nil {
+		t.Error("non-nil error")
+	}
+	_, err = cron.ScheduleContext(sched, func(context.Context) error {
+		return errors.New("job failed")
+	})
+	if err != nil {
+		t.Error("non-nil error")
+	}
+
+	clock.AdvanceBy(time.Second)
+	if !strings.Contains(buf.String(), "job failed") {
+		t.Error("expected the job error to be logged, got none")
+	}
+}
+
+// Stop cancels the context of the running jobs, and the context it returns is
+// done once they have returned.
+func TestScheduleContextCancelledByStop(t *testing.T) {
+	clock := NewTimerSkippingRealExecutionClock(start)
+	cron := New(WithClock(clock))
+	sched, err := secondParser.Parse("1 0 19 * * ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	var cancelled atomic.Bool
+	_, err = cron.ScheduleContext(sched, func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		cancelled.Store(true)
+		return ctx.Err()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+
+	clock.AdvanceBy(time.Second)
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("expected job to be started")
+	}
+
+	select {
+	case <-cron.Stop().Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected running job to return once its context was cancelled")
+	}
+	if !cancelled.Load() {
+		t.Error("expected job context to be cancelled by Stop")
+	}
+}
+
+// The outcome of the last completed run is recorded on the entry snapshot,
+// counting consecutive failures, panics included, until a run succeeds.
+func TestEntryOutcome(t *testing.T) {
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock))
+	sched, err := secondParser.Parse("* * * * * ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var runs int
+	id, err := cron.ScheduleContext(sched, func(context.Context) error {
+		runs++
+		switch runs {
+		case 1:
+			return errors.New("first failure")
+		case 2:
+			panic("second failure")
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry := cron.Entry(id); !entry.LastStart.IsZero() || entry.LastError != nil {
+		t.Errorf("expected no outcome before the first run, got %+v", entry)
+	}
+
+	cron.Start()
+	defer cron.Stop()
+
+	clock.AdvanceBy(time.Second)
+	entry := cron.Entry(id)
+	if entry.LastError == nil || entry.LastError.Error() != "first failure" {
+		t.Errorf("expected first failure, got %v", entry.LastError)
+	}
+	if entry.ConsecutiveFailures != 1 {
+		t.Errorf("expected 1 consecutive failure, got %d", entry.ConsecutiveFailures)
+	}
+	if want := start.Add(time.Second); !entry.LastStart.Equal(want) || !entry.LastEnd.Equal(want) {
+		t.Errorf("expected run started and ended at %v, got %v and %v", want, entry.LastStart, entry.LastEnd)
+	}
+
+	clock.AdvanceBy(time.Second)
+	entry = cron.Entry(id)
+	if entry.LastError == nil || entry.LastError.Error() != "second failure" {
+		t.Errorf("expected the recovered panic, got %v", entry.LastError)
+	}
+	if entry.ConsecutiveFailures != 2 {
+		t.Errorf("expected 2 consecutive failures, got %d", entry.ConsecutiveFailures)
+	}
+
+	clock.AdvanceBy(time.Second)
+	entry = cron.Entry(id)
+	if entry.LastError != nil || entry.ConsecutiveFailures != 0 {
+		t.Errorf("expected success to reset failures, got %v and %d", entry.LastError, entry.ConsecutiveFailures)
+	}
+	if want := start.Add(3 * time.Second); !entry.LastStart.Equal(want) {
+		t.Errorf("expected last run started at %v, got %v", want, entry.LastStart)
+	}
+}
+
 // Start and stop cron with no entries.
 func TestNoEntries(t *testing.T) {
 	cron := New()
@@ -206,6 +335,312 @@ func TestRemoveWhileRunning(t *testing.T) {
 	}
 }
 
+// A paused entry keeps its ID and Prev time but does not run until resumed.
+func TestPauseAndResume(t *testing.T) {
+	var runs atomic.Int32
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock))
+	sched, err := secondParser.Parse("* * * * * ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := cron.Schedule(sched, func() { runs.Add(1) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	defer cron.Stop()
+
+	clock.AdvanceBy(time.Second)
+	if err := cron.Pause(id); err != nil {
+		t.Fatal(err)
+	}
+	entry := cron.Entry(id)
+	if !entry.Paused || !entry.Next.IsZero() {
+		t.Errorf("expected paused entry with no next activation, got %+v", entry)
+	}
+	if want := start.Add(time.Second); !entry.Prev.Equal(want) {
+		t.Errorf("expected Prev %v to survive the pause, got %v", want, entry.Prev)
+	}
+
+	clock.AdvanceBy(2 * time.Second)
+	if n := runs.Load(); n != 1 {
+		t.Errorf("expected paused entry not to run, got %d runs", n)
+	}
+
+	if err := cron.Resume(id); err != nil {
+		t.Fatal(err)
+	}
+	if entry := cron.Entry(id); entry.Paused || !entry.Next.Equal(start.Add(4*time.Second)) {
+		t.Errorf("expected resumed entry due at the next activation, got %+v", entry)
+	}
+	clock.AdvanceBy(time.Second)
+	if n := runs.Load(); n != 2 {
+		t.Errorf("expected resumed entry to run, got %d runs", n)
+	}
+}
+
+// An entry paused before the scheduler is started is not run.
+func TestPauseBeforeRunning(t *testing.T) {
+	executed := false
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock))
+	sched, err := secondParser.Parse("* * * * * ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := cron.Schedule(sched, func() { executed = true })
+	if err := cron.Pause(id); err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	defer cron.Stop()
+
+	clock.AdvanceBy(time.Second)
+	if executed {
+		t.Error("expected paused job not to be executed")
+	}
+}
+
+func TestPauseUnknownEntry(t *testing.T) {
+	cron := New()
+	if err := cron.Pause(1); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("expected ErrEntryNotFound, got %v", err)
+	}
+	cron.Start()
+	defer cron.Stop()
+	if err := cron.Resume(1); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("expected ErrEntryNotFound, got %v", err)
+	}
+}
+
+// A rescheduled entry keeps its ID and Prev time, running on the new schedule.
+func TestReschedule(t *testing.T) {
+	var runs atomic.Int32
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock))
+	sched, err := secondParser.Parse("* * * * * ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := cron.Schedule(sched, func() { runs.Add(1) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	defer cron.Stop()
+
+	clock.AdvanceBy(time.Second)
+	if err := cron.Reschedule(id, must(every(3*time.Second))); err != nil {
+		t.Fatal(err)
+	}
+	entry := cron.Entry(id)
+	if want := start.Add(time.Second); !entry.Prev.Equal(want) {
+		t.Errorf("expected Prev %v to survive the reschedule, got %v", want, entry.Prev)
+	}
+	if want := start.Add(4 * time.Second); !entry.Next.Equal(want) {
+		t.Errorf("expected next activation computed from the new schedule %v, got %v", want, entry.Next)
+	}
+
+	clock.AdvanceBy(2 * time.Second)
+	if n := runs.Load(); n != 1 {
+		t.Errorf("expected the old schedule not to fire anymore, got %d runs", n)
+	}
+	clock.AdvanceBy(time.Second)
+	if n := runs.Load(); n != 2 {
+		t.Errorf("expected the new schedule to fire, got %d runs", n)
+	}
+}
+
+func TestRescheduleUnknownEntry(t *testing.T) {
+	cron := New()
+	if err := cron.Reschedule(1, must(every(time.Second))); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("expected ErrEntryNotFound, got %v", err)
+	}
+}
+
+// A triggered entry runs right away, leaving its Next time untouched.
+func TestTrigger(t *testing.T) {
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ran := make(chan struct{})
+	id, err := cron.Schedule(sched, func() { close(ran) })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cron.Trigger(id); !errors.Is(err, ErrNotRunning) {
+		t.Errorf("expected ErrNotRunning, got %v", err)
+	}
+
+	cron.Start()
+	defer cron.Stop()
+	next := cron.Entry(id).Next
+
+	dispatch, err := cron.Trigger(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dispatch != DispatchStarted {
+		t.Errorf("expected triggered job started, got %v", dispatch)
+	}
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("expected triggered job to run")
+	}
+	if entry := cron.Entry(id); !entry.Next.Equal(next) || !entry.Prev.IsZero() {
+		t.Errorf("expected Next and Prev untouched, got %v and %v", entry.Next, entry.Prev)
+	}
+
+	if _, err := cron.Trigger(id + 1); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("expected ErrEntryNotFound, got %v", err)
+	}
+}
+
+// A triggered run is subject to the overlap policy, which tells its fate.
+// Entries given a name can be looked up by it, and names are unique.
+func TestEntryNames(t *testing.T) {
+	var buf syncWriter
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock), WithLogger(logger))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ran := make(chan struct{})
+	id, err := cron.Schedule(sched, func() { close(ran) }, EntryName("report"), EntryDescription("Sends the daily report"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dup *DuplicateNameError
+	if _, err := cron.Schedule(sched, func() {}, EntryName("report")); !errors.As(err, &dup) || dup.Name != "report" {
+		t.Errorf("expected DuplicateNameError, got %v", err)
+	}
+
+	cron.Start()
+	defer cron.Stop()
+	entry := cron.EntryByName("report")
+	if entry.ID != id || entry.Name != "report" || entry.Description != "Sends the daily report" {
+		t.Errorf("expected the named entry, got %+v", entry)
+	}
+	if _, err := cron.TriggerByName("report"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("expected triggered job to run")
+	}
+	if !strings.Contains(buf.String(), "name=report") {
+		t.Error("expected the name of the entry logged")
+	}
+
+	if err := cron.RemoveByName("report"); err != nil {
+		t.Fatal(err)
+	}
+	if entry := cron.EntryByName("report"); entry.ID != 0 {
+		t.Errorf("expected the entry removed, got %+v", entry)
+	}
+	if _, err := cron.TriggerByName("report"); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("expected ErrEntryNotFound, got %v", err)
+	}
+	if err := cron.RemoveByName("report"); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("expected ErrEntryNotFound, got %v", err)
+	}
+	if _, err := cron.Schedule(sched, func() {}, EntryName("report")); err != nil {
+		t.Errorf("expected the name of the removed entry free, got %v", err)
+	}
+}
+
+func TestTriggerOverlap(t *testing.T) {
+	cases := []struct {
+		name   string
+		option Option
+		want   Dispatch
+	}{
+		{"skip", WithSkipIfRunning(), DispatchSkipped},
+		{"queue", WithQueueIfRunning(), DispatchQueued},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cron := New(tc.option)
+			sched, err := secondParser.Parse("0 0 0 1 1 ?")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var runs atomic.Int32
+			release := make(chan struct{})
+			id, err := cron.Schedule(sched, func() {
+				runs.Add(1)
+				<-release
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			cron.Start()
+
+			if dispatch, err := cron.Trigger(id); err != nil || dispatch != DispatchStarted {
+				t.Fatalf("expected first run started, got %v, %v", dispatch, err)
+			}
+			if dispatch, err := cron.Trigger(id); err != nil || dispatch != tc.want {
+				t.Errorf("expected overlapping run %v, got %v, %v", tc.want, dispatch, err)
+			}
+			close(release)
+			<-cron.Stop().Done()
+
+			want := int32(1)
+			if tc.want == DispatchQueued {
+				want = 2
+			}
+			if n := runs.Load(); n != want {
+				t.Errorf("expected %d runs, got %d", want, n)
+			}
+		})
+	}
+}
+
+// Entries coming due at the same wake-up are started by priority, higher
+// first, then in the order of their activation times.
+func TestPriorityDispatchOrder(t *testing.T) {
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock), WithWorkerPool(1))
+	sched, err := secondParser.Parse("* * * * * ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mu sync.Mutex
+	var order []string
+	for _, tc := range []struct {
+		name     string
+		priority int
+	}{{"low", -1}, {"normal", 0}, {"high", 10}} {
+		name := tc.name
+		_, err := cron.Schedule(sched, func() {
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+		}, EntryPriority(tc.priority))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	cron.Start()
+	defer cron.Stop()
+
+	clock.AdvanceBy(time.Second)
+	mu.Lock()
+	defer mu.Unlock()
+	if got := strings.Join(order, ","); got != "high,normal,low" {
+		t.Errorf("expected jobs run by priority, got %s", got)
+	}
+}
+
 // Test timing with Entries.
 func TestSnapshotEntries(t *testing.T) {
 	executed := false
@@ -38,10 +38,100 @@ them in their own goroutines.
 	sched, _ = parser.Parse("@daily")
 	c.Schedule(sched, func() { fmt.Println("Every day") })
 	..
-	// Inspect the cron job entries' next and previous run times.
+	// Inspect the cron job entries' next and previous run times, and the
+	// outcome of their last run.
 	inspect(c.Entries())
 	..
-	c.Stop()  // Stop the scheduler (does not stop any jobs already running).
+	c.Stop()  // Stop the scheduler (does not wait for any jobs already running).
+
+# Context-aware jobs
+
+Jobs that need to know when the scheduler shuts down can be registered with
+[Cron.ScheduleContext]. Each run receives a context that is cancelled by
+[Cron.Stop], and may return an error, which is logged:
+
+	c.ScheduleContext(sched, func(ctx context.Context) error {
+		return export(ctx) // aborts cleanly when c.Stop() is called
+	})
+
+The context also describes the run, with the activation time it is for, so
+that a job can key its work on it rather than on the current time, which is
+off when the run started late or was queued:
+
+	c.ScheduleContext(hourly, func(ctx context.Context) error {
+		run, _ := cron.RunInfoFromContext(ctx)
+		return aggregate(ctx, run.Scheduled.Add(-time.Hour), run.Scheduled)
+	})
+
+# Job wrappers
+
+Behavior common to many jobs, such as logging, tracing or taking a distributed
+lock, can be layered around them by [JobWrapper] middlewares. The [WithChain]
+option wraps the jobs of every entry, the [EntryChain] entry option the one of
+a single entry; the wrappers of the Cron wrap those of the entry, and within
+each list the first wrapper given is the outermost:
+
+	traced := func(job cron.Job) cron.Job {
+		return func(ctx context.Context) error {
+			run, _ := cron.RunInfoFromContext(ctx)
+			ctx, span := tracer.Start(ctx, run.Name)
+			defer span.End()
+			return job(ctx)
+		}
+	}
+	c := cron.New(cron.WithChain(traced))
+	c.ScheduleContext(nightly, backup, cron.EntryChain(withLock("backup")))
+
+Wrappers run within the overlap policy, the timeout and the panic recovery of
+the runs, which the scheduler applies itself. The same behaviors are provided
+as wrappers, to be layered at a given place among the others: [Recover] turns
+panics into errors, [Timeout] bounds the wrapped job, and [SkipIfStillRunning]
+and [DelayIfStillRunning] guard it against overlapping calls. For instance, to
+skip a run while the previous one is still in progress, rather than waiting
+for a distributed lock:
+
+	c.ScheduleContext(nightly, backup, cron.EntryChain(
+		cron.SkipIfStillRunning(logger),
+		withLock("backup"),
+		cron.Recover(),
+	))
+
+# Managing entries
+
+An entry can be temporarily disabled with [Cron.Pause] and brought back with
+[Cron.Resume]. Unlike [Cron.Remove], pausing keeps the entry's ID, its Prev time
+and its overlap guard. Likewise, [Cron.Reschedule] swaps the schedule of an
+entry in place, and [Cron.Trigger] runs it right away, outside of its schedule:
+
+	id, _ := c.Schedule(sched, job)
+	c.Pause(id)  // the job is not run anymore...
+	c.Resume(id) // ...until resumed, from its next activation on
+	c.Reschedule(id, hourly)
+	c.Trigger(id) // run now, subject to the overlap policy
+
+IDs are assigned anew each time the process starts. An entry can also be given
+a name, unique within the Cron, which is logged and by which it can be looked
+up; scheduling another entry with the same name fails with a
+[DuplicateNameError]:
+
+	c.Schedule(nightly, backup, cron.EntryName("backup"), cron.EntryDescription("Backs up the database"))
+	..
+	c.TriggerByName("backup")
+	c.RemoveByName("backup")
+
+Entries can be grouped with labels, and operated on by group with a
+[Selector]. The scheduler matches and changes the entries at once, so that none
+of them comes due halfway through:
+
+	c.Schedule(sched, job, cron.EntryLabels(map[string]string{"tenant": "acme", "team": "billing"}))
+	..
+	c.PauseMatching(cron.Selector{"tenant": "acme"})
+	c.RemoveMatching(cron.Selector{"team": "billing"})
+
+Besides the outcome of the last run reported by each entry, the last runs of
+an entry are retained, up to the size set by [WithHistorySize]:
+
+	records, _ := c.History(id) // oldest first, with their outcome and error
 
 # CRON Expression Format
 
@@ -221,6 +311,7 @@ same job are handled. For example:
 
   - Skip a job's execution if the previous run hasn't completed yet
   - Delay (queue) a job's execution until the previous run has completed
+  - Cancel (replace) the previous run, starting the new one once it returns
 
 Skip overlapping executions using the [WithSkipIfRunning] option:
 
@@ -230,6 +321,176 @@ Queue overlapping executions using the [WithQueueIfRunning] option:
 
 	cron.New(cron.WithQueueIfRunning())
 
+Replace overlapping executions using the [WithReplaceIfRunning] option, which
+cancels the context given to the previous run (see [Cron.ScheduleContext]):
+
+	cron.New(cron.WithReplaceIfRunning())
+
+Those policies allow a single run of a job at once. The [WithMaxConcurrentRuns]
+option allows a few, either skipping the activations beyond them or queueing
+them up to a bounded depth:
+
+	cron.New(cron.WithMaxConcurrentRuns(3, cron.QueueWhenFull(10)))
+
+Those options set the policy for every entry of the Cron. A single entry may
+override it when scheduled, the policy in effect being reported by its
+Entry.Overlap field:
+
+	c := cron.New(cron.WithSkipIfRunning())
+	c.Schedule(sched, job, cron.EntryQueueIfRunning())
+
+# Worker pool
+
+Every activation runs in its own goroutine, so that many entries coming due at
+once run as many jobs at once. The [WithWorkerPool] option bounds them across
+all the entries of a Cron, the activations beyond waiting for a worker in the
+order they came due:
+
+	c := cron.New(cron.WithWorkerPool(4))
+	..
+	stats := c.Stats() // busy workers, queued activations and their wait
+
+Entries competing for workers may be given a priority: among the entries
+coming due at the same wake-up, and among the activations waiting for a worker,
+higher priorities go first.
+
+	c.Schedule(billingClose, closeBooks, cron.EntryPriority(10))
+	c.Schedule(everyMinute, warmCaches, cron.EntryPriority(-10))
+
+# Misfires
+
+The scheduler may wake up too late to start some activations on time: after a
+GC pause, a suspended host or a stall. An activation started more than a
+tolerance after its time is missed, and logged as such. By default, the job is
+then run once in place of all the missed activations; the [WithMisfire] option
+and the [EntryMisfire] entry option configure it otherwise:
+
+	c := cron.New(cron.WithMisfire(cron.Misfire{Policy: cron.MisfireSkip}))
+	..
+	c.Schedule(hourly, chargeSubscriptions, cron.EntryMisfire(cron.Misfire{
+		Policy:    cron.MisfireFireAll,
+		Tolerance: time.Minute,
+		Cap:       24,
+	}))
+
+Short of missing activations, runs may start late: the lag of each run behind
+its activation time is reported by [RunInfo.Lag] and Entry.LastLag, and the
+[WithLagThreshold] option logs a warning for the runs lagging too much.
+
+# Restarts
+
+A stopped scheduler may be started again, as a pause of all the entries: they
+keep their Prev times, paused states and pending retries, and the runs still
+in progress hold back the overlapping ones per the overlap policy. By default,
+the entries resume from the restart on, dropping the activations missed in
+between. With the [RestartCatchUp] policy, they resume from where they were
+instead, the activations missed being handled by their misfire policy:
+
+	c := cron.New(cron.WithRestartPolicy(cron.RestartCatchUp))
+
+# Retries
+
+A failed run, whose job returned an error or panicked, is not retried until the
+next activation of its entry, unless given a [RetryPolicy] by the [WithRetry]
+option or the [EntryRetry] entry option. Retries wait for an exponential
+backoff, go through the overlap policy of the entry and keep the activation
+time of the failed run; the job is told the attempt it runs for by its
+[RunInfo]:
+
+	c.ScheduleContext(nightly, export, cron.EntryRetry(cron.RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     time.Minute,
+		MaxBackoff:  time.Hour,
+		Jitter:      0.2,
+		RetryIf:     isTransient,
+	}))
+
+# Panics
+
+The panics of the jobs are recovered from: the run is logged with the stack of
+the panic, reported to the hooks and recorded as failed, and the scheduler goes
+on. The [WithPanicPolicy] option and the [EntryPanicPolicy] entry option
+configure what happens next: calling a handler, pausing the entries that keep
+panicking, or panicking again so that the process crashes and gets restarted
+by its supervisor:
+
+	c := cron.New(cron.WithPanicPolicy(cron.PanicPolicy{
+		Handler: func(run cron.RunInfo, value any, stack []byte) {
+			sentry.CaptureException(fmt.Errorf("job %s: %v", run.Name, value))
+		},
+		PauseAfter: 3,
+	}))
+
+# Timeouts
+
+A run that never returns holds its overlap guard and its worker, and keeps the
+context returned by [Cron.Stop] from ever being done. The [WithRunTimeout]
+option and the [EntryRunTimeout] entry option bound the runs, cancelling their
+context at the timeout and recording them as failed with [ErrRunTimeout]. Runs
+whose job ignores the cancellation of its context may also be abandoned after a
+grace period: the scheduler stops waiting for them, while [Cron.Stats] keeps
+count of their goroutines until they return.
+
+	c := cron.New(cron.WithRunTimeout(cron.RunTimeout{
+		Timeout:      10 * time.Minute,
+		AbandonAfter: time.Minute,
+	}))
+
+# Shutdown
+
+[Cron.Stop] cancels the context of the running jobs at once. To let them
+complete first, [Cron.Shutdown] stops the scheduler and waits for them until
+the context given is done, then cancels them and waits a further grace period,
+see [WithShutdownGrace]. It reports the entries whose jobs still had not
+returned with a [ShutdownError]:
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := c.Shutdown(ctx); err != nil {
+		log.Printf("cron: %v", err)
+	}
+
+# Persistence
+
+Entries live in memory only, so that after a restart of the process they know
+nothing of their past runs. The [WithStore] option saves the state of the
+entries given a name, after each of their runs, and restores it when an entry
+is scheduled again with the same name: its Prev time and last outcome, the
+activations missed in between being handled like any other misfire. The
+[FileStore] keeps the states in a JSON file:
+
+	store, err := cron.NewFileStore("/var/lib/myapp/cron.json")
+	..
+	c := cron.New(cron.WithStore(store))
+	c.Schedule(nightly, backup, cron.EntryName("backup"))
+
+# Hooks
+
+Besides being logged, the lifecycle events of the scheduler, of the entries and
+of their runs are given to the [Hooks] registered with the [WithHooks] option,
+for emitting metrics or audit records. Embedding [NopHooks] spares implementing
+the events of no interest:
+
+	type failures struct {
+		cron.NopHooks
+	}
+
+	func (failures) OnJobEnd(run cron.RunInfo, d time.Duration, err error) {
+		if err != nil {
+			failuresCounter.WithLabelValues(run.Name).Inc()
+		}
+	}
+	..
+	c := cron.New(cron.WithHooks(failures{}))
+
+[Metrics] is such hooks, collecting the runs of each job by outcome, their
+duration, their lag behind their activation time, and the activations held
+back by the overlap policies or missed, served in the Prometheus text format:
+
+	m := cron.NewMetrics()
+	c := cron.New(cron.WithHooks(m))
+	http.Handle("/metrics", m)
+
 # Thread safety
 
 Since the Cron service runs concurrently with the calling code, some amount of
@@ -0,0 +1,126 @@
+package cron
+
+import "maps"
+
+// EntryOption represents a modification to the default behavior of a single
+// entry, given when scheduling it. Entry options take precedence over the
+// corresponding Cron options.
+type EntryOption func(*Entry)
+
+// EntryLabels adds the given labels to the entry, see Entry.Labels.
+func EntryLabels(labels map[string]string) EntryOption {
+	return func(e *Entry) {
+		if e.Labels == nil {
+			e.Labels = make(map[string]string, len(labels))
+		}
+		maps.Copy(e.Labels, labels)
+	}
+}
+
+// EntryPriority sets the priority of the entry, see Entry.Priority.
+func EntryPriority(priority int) EntryOption {
+	return func(e *Entry) {
+		e.Priority = priority
+	}
+}
+
+// EntryName gives the entry a name, unique within the Cron, by which it can be
+// looked up, see [Cron.EntryByName]. The name is logged along with the ID of
+// the entry, and is the one its state is persisted under, see [WithStore].
+func EntryName(name string) EntryOption {
+	return func(e *Entry) {
+		e.Name = name
+	}
+}
+
+// EntryDescription gives the entry a description, see Entry.Description.
+func EntryDescription(description string) EntryOption {
+	return func(e *Entry) {
+		e.Description = description
+	}
+}
+
+// EntryChain wraps the job of the entry with the given wrappers, the first
+// given being the outermost. They are wrapped in turn by the ones of the Cron,
+// see [WithChain].
+func EntryChain(wrappers ...JobWrapper) EntryOption {
+	return func(e *Entry) {
+		e.chain = append(e.chain, wrappers...)
+	}
+}
+
+// EntryMisfire sets how the activations of the entry missed by the scheduler
+// are handled, like [WithMisfire] does for every entry of a Cron.
+func EntryMisfire(misfire Misfire) EntryOption {
+	return func(e *Entry) {
+		e.Misfire = misfire
+	}
+}
+
+// EntryRunTimeout bounds the runs of the entry, like [WithRunTimeout] does for
+// every entry of a Cron.
+func EntryRunTimeout(timeout RunTimeout) EntryOption {
+	return func(e *Entry) {
+		e.RunTimeout = timeout
+	}
+}
+
+// EntryPanicPolicy sets how the runs of the entry whose job panicked are
+// handled, like [WithPanicPolicy] does for every entry of a Cron.
+func EntryPanicPolicy(policy PanicPolicy) EntryOption {
+	return func(e *Entry) {
+		e.PanicPolicy = policy
+	}
+}
+
+// EntryRetry sets how the failed runs of the entry are retried, like
+// [WithRetry] does for every entry of a Cron.
+func EntryRetry(retry RetryPolicy) EntryOption {
+	return func(e *Entry) {
+		e.Retry = retry
+	}
+}
+
+// EntryAllowOverlap lets successive runs of the entry overlap, whatever the
+// overlap policy of the Cron.
+func EntryAllowOverlap() EntryOption {
+	return func(e *Entry) {
+		e.Overlap, e.MaxConcurrentRuns, e.MaxQueuedRuns = OverlapAllow, 0, 0
+	}
+}
+
+// EntrySkipIfRunning skips an activation of the entry that comes due while its
+// previous run is still in progress, like [WithSkipIfRunning] does for every
+// entry of a Cron.
+func EntrySkipIfRunning() EntryOption {
+	return func(e *Entry) {
+		e.Overlap, e.MaxConcurrentRuns, e.MaxQueuedRuns = OverlapSkip, 1, 0
+	}
+}
+
+// EntryQueueIfRunning defers an activation of the entry that comes due while
+// its previous run is still in progress, like [WithQueueIfRunning] does for
+// every entry of a Cron.
+func EntryQueueIfRunning() EntryOption {
+	return func(e *Entry) {
+		e.Overlap, e.MaxConcurrentRuns, e.MaxQueuedRuns = OverlapQueue, 1, 0
+	}
+}
+
+// EntryReplaceIfRunning cancels the previous run of the entry when an
+// activation comes due while it is still in progress, like
+// [WithReplaceIfRunning] does for every entry of a Cron.
+func EntryReplaceIfRunning() EntryOption {
+	return func(e *Entry) {
+		e.Overlap, e.MaxConcurrentRuns, e.MaxQueuedRuns = OverlapReplace, 1, 0
+	}
+}
+
+// EntryMaxConcurrentRuns lets up to n runs of the entry be in progress at once,
+// whenFull telling what becomes of the activations beyond, like
+// [WithMaxConcurrentRuns] does for every entry of a Cron.
+func EntryMaxConcurrentRuns(n int, whenFull Policy) EntryOption {
+	return func(e *Entry) {
+		e.Overlap, e.MaxConcurrentRuns, e.MaxQueuedRuns = whenFull.overlap(), max(n, 1), whenFull.depth
+	}
+}
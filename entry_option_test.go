@@ -0,0 +1,67 @@
+package cron
+
+import "testing"
+
+// Entry overlap options override the policy of the Cron, the effective one
+// being visible on the entry snapshot.
+func TestEntryOverlapOptions(t *testing.T) {
+	cases := []struct {
+		name   string
+		cron   []Option
+		entry  []EntryOption
+		policy OverlapPolicy
+		second Dispatch
+	}{
+		{"cron default", nil, nil, OverlapAllow, DispatchStarted},
+		{"cron policy", []Option{WithQueueIfRunning()}, nil, OverlapQueue, DispatchQueued},
+		{"entry skip", nil, []EntryOption{EntrySkipIfRunning()}, OverlapSkip, DispatchSkipped},
+		{"entry queue", []Option{WithSkipIfRunning()}, []EntryOption{EntryQueueIfRunning()}, OverlapQueue, DispatchQueued},
+		{"entry allow", []Option{WithSkipIfRunning()}, []EntryOption{EntryAllowOverlap()}, OverlapAllow, DispatchStarted},
+		{"entry replace", nil, []EntryOption{EntryReplaceIfRunning()}, OverlapReplace, DispatchReplaced},
+		{"entry max runs", []Option{WithSkipIfRunning()}, []EntryOption{EntryMaxConcurrentRuns(2, SkipWhenFull())}, OverlapSkip, DispatchStarted},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cron := New(tc.cron...)
+			sched, err := secondParser.Parse("0 0 0 1 1 ?")
+			if err != nil {
+				t.Fatal(err)
+			}
+			release := make(chan struct{})
+			id, err := cron.Schedule(sched, func() { <-release }, tc.entry...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if policy := cron.Entry(id).Overlap; policy != tc.policy {
+				t.Errorf("expected %v policy on the entry, got %v", tc.policy, policy)
+			}
+
+			cron.Start()
+			if dispatch, err := cron.Trigger(id); err != nil || dispatch != DispatchStarted {
+				t.Fatalf("expected first run started, got %v, %v", dispatch, err)
+			}
+			if dispatch, err := cron.Trigger(id); err != nil || dispatch != tc.second {
+				t.Errorf("expected overlapping run %v, got %v, %v", tc.second, dispatch, err)
+			}
+			close(release)
+			<-cron.Stop().Done()
+		})
+	}
+}
+
+func TestEntryMaxConcurrentRuns(t *testing.T) {
+	cron := New(WithMaxConcurrentRuns(3, SkipWhenFull()))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := cron.Schedule(sched, func() {}, EntryMaxConcurrentRuns(2, QueueWhenFull(5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := cron.Entry(id)
+	if entry.Overlap != OverlapQueue || entry.MaxConcurrentRuns != 2 || entry.MaxQueuedRuns != 5 {
+		t.Errorf("expected 2 runs at once and 5 queued, got %v, %d and %d",
+			entry.Overlap, entry.MaxConcurrentRuns, entry.MaxQueuedRuns)
+	}
+}
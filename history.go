@@ -0,0 +1,79 @@
+package cron
+
+import "time"
+
+// DefaultHistorySize is the number of completed runs retained for each entry,
+// unless configured otherwise, see [WithHistorySize].
+const DefaultHistorySize = 10
+
+// Outcome tells how a run of a job ended.
+type Outcome int
+
+const (
+	// OutcomeSuccess is the outcome of a run whose job returned no error.
+	OutcomeSuccess Outcome = iota
+	// OutcomeError is the outcome of a run whose job returned an error.
+	OutcomeError
+	// OutcomePanic is the outcome of a run whose job panicked.
+	OutcomePanic
+	// OutcomeTimeout is the outcome of a run that outlasted its timeout, see
+	// [RunTimeout].
+	OutcomeTimeout
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case OutcomeSuccess:
+		return "success"
+	case OutcomeError:
+		return "error"
+	case OutcomePanic:
+		return "panic"
+	case OutcomeTimeout:
+		return "timeout"
+	default:
+		return "unknown"
+	}
+}
+
+// RunRecord is a completed run of a job, as retained in the history of its
+// entry, see [Cron.History].
+type RunRecord struct {
+	RunInfo
+
+	// End is the time the job returned.
+	End time.Time
+
+	// Outcome tells how the run ended.
+	Outcome Outcome
+
+	// Error is the message of the error the run failed with, or of the panic it
+	// recovered from; empty if it succeeded.
+	Error string
+}
+
+// runHistory retains the last completed runs of an entry, up to its capacity.
+type runHistory struct {
+	records []RunRecord
+	// next is the index of records the next run is retained at, once full.
+	next int
+}
+
+func (h *runHistory) add(size int, record RunRecord) {
+	if size <= 0 {
+		return
+	}
+	if len(h.records) < size {
+		h.records = append(h.records, record)
+		return
+	}
+	h.records[h.next] = record
+	h.next = (h.next + 1) % size
+}
+
+// list returns the retained runs, oldest first.
+func (h *runHistory) list() []RunRecord {
+	records := make([]RunRecord, 0, len(h.records))
+	records = append(records, h.records[h.next:]...)
+	return append(records, h.records[:h.next]...)
+}
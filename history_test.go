@@ -0,0 +1,80 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRunHistory(t *testing.T) {
+	var h runHistory
+	for i := 1; i <= 5; i++ {
+		h.add(3, RunRecord{RunInfo: RunInfo{Attempt: i}})
+	}
+	records := h.list()
+	if len(records) != 3 {
+		t.Fatalf("expected 3 runs retained, got %d", len(records))
+	}
+	for i, r := range records {
+		if r.Attempt != i+3 {
+			t.Errorf("expected run %d at index %d, got %d", i+3, i, r.Attempt)
+		}
+	}
+
+	var none runHistory
+	none.add(0, RunRecord{})
+	if records := none.list(); len(records) != 0 {
+		t.Errorf("expected no run retained, got %v", records)
+	}
+}
+
+// The history of an entry retains its last runs, whatever their outcome.
+func TestHistory(t *testing.T) {
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock), WithHistorySize(2))
+	sched, err := secondParser.Parse("* * * * * ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var runs int
+	id, err := cron.ScheduleContext(sched, func(context.Context) error {
+		runs++
+		switch runs {
+		case 1:
+			return errors.New("failed")
+		case 2:
+			panic("YOLO")
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if records, err := cron.History(id); err != nil || len(records) != 0 {
+		t.Errorf("expected an empty history, got %v and %v", records, err)
+	}
+	cron.Start()
+	defer cron.Stop()
+	clock.AdvanceBy(3 * time.Second)
+
+	records, err := cron.History(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected the last 2 runs retained, got %+v", records)
+	}
+	if r := records[0]; r.Outcome != OutcomePanic || r.Error != "YOLO" || !r.Scheduled.Equal(start.Add(2*time.Second)) {
+		t.Errorf("expected the run that panicked, got %+v", r)
+	}
+	if r := records[1]; r.Outcome != OutcomeSuccess || r.Error != "" || !r.End.Equal(start.Add(3*time.Second)) {
+		t.Errorf("expected the run that succeeded, got %+v", r)
+	}
+
+	cron.Remove(id)
+	if _, err := cron.History(id); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("expected ErrEntryNotFound, got %v", err)
+	}
+}
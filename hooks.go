@@ -0,0 +1,174 @@
+package cron
+
+import (
+	"context"
+	"time"
+)
+
+// RunInfo describes a run of a job, as given to [Hooks] and to the job itself
+// through its context, see [RunInfoFromContext].
+type RunInfo struct {
+	// ID is the ID of the entry the run belongs to.
+	ID ID
+
+	// Name is the name of the entry the run belongs to, if any.
+	Name string
+
+	// Scheduled is the activation time of the run, or the zero time if it was
+	// triggered, see [Cron.Trigger].
+	Scheduled time.Time
+
+	// Start is the time the job was called, or the zero time if it has not
+	// been yet.
+	Start time.Time
+
+	// Attempt numbers the attempts at running the job for the activation,
+	// starting from 1.
+	Attempt int
+}
+
+type runInfoKey struct{}
+
+// RunInfoFromContext returns the description of the run of the job given ctx,
+// so that the job can key its work on its activation time rather than on the
+// current time. It reports false if ctx is not the context of a run.
+func RunInfoFromContext(ctx context.Context) (RunInfo, bool) {
+	run, ok := ctx.Value(runInfoKey{}).(RunInfo)
+	return run, ok
+}
+
+// Lag returns how late the run started after its activation time: because the
+// scheduler woke up late, or the run waited for the overlap policy of the
+// entry or for a worker. It is zero for runs triggered, retried or not started
+// yet.
+func (r RunInfo) Lag() time.Duration {
+	if r.Scheduled.IsZero() || r.Start.IsZero() || r.Attempt > 1 {
+		return 0
+	}
+	return r.Start.Sub(r.Scheduled)
+}
+
+// Hooks receives the lifecycle events of a Cron and of its entries and runs,
+// see [WithHooks]. The methods are called synchronously, by the scheduler or
+// by the goroutines running the jobs, so they must be safe for concurrent use
+// and return quickly. Embed [NopHooks] to implement only some of them.
+//
+// OnSchedulerStart, OnMisfire and OnSkip may be called by the scheduler
+// goroutine, which they hold up: they must not call the methods of the Cron,
+// which would wait for the scheduler and deadlock, but may hand such calls
+// off to another goroutine. The other methods are called with no lock of the
+// Cron held, and may call it.
+type Hooks interface {
+	// OnSchedulerStart is called when the scheduler starts, at now.
+	OnSchedulerStart(now time.Time)
+
+	// OnSchedulerStop is called when the scheduler stops, at now. Jobs may
+	// still be running.
+	OnSchedulerStop(now time.Time)
+
+	// OnScheduled is called when an entry is added.
+	OnScheduled(entry Entry)
+
+	// OnRemoved is called when an entry is removed.
+	OnRemoved(entry Entry)
+
+	// OnJobStart is called before the job of a run is called.
+	OnJobStart(run RunInfo)
+
+	// OnJobEnd is called once the job of a run has returned, taking duration,
+	// with the error it returned or the panic it recovered from.
+	OnJobEnd(run RunInfo, duration time.Duration, err error)
+
+	// OnPanic is called when the job of a run panics, with the value it
+	// panicked with and the stack trace of the goroutine. OnJobEnd follows.
+	OnPanic(run RunInfo, value any, stack []byte)
+
+	// OnSkip is called when an activation is not run, because of the overlap
+	// policy of the entry.
+	OnSkip(run RunInfo)
+
+	// OnDelay is called when an activation held back by the overlap policy of
+	// the entry may start, having waited for delay.
+	OnDelay(run RunInfo, delay time.Duration)
+
+	// OnMisfire is called for each activation missed by the scheduler, whatever
+	// the misfire policy of the entry, see [Misfire].
+	OnMisfire(run RunInfo)
+}
+
+// NopHooks implements [Hooks], doing nothing.
+type NopHooks struct{}
+
+func (NopHooks) OnSchedulerStart(time.Time)             {}
+func (NopHooks) OnSchedulerStop(time.Time)              {}
+func (NopHooks) OnScheduled(Entry)                      {}
+func (NopHooks) OnRemoved(Entry)                        {}
+func (NopHooks) OnJobStart(RunInfo)                     {}
+func (NopHooks) OnJobEnd(RunInfo, time.Duration, error) {}
+func (NopHooks) OnPanic(RunInfo, any, []byte)           {}
+func (NopHooks) OnSkip(RunInfo)                         {}
+func (NopHooks) OnDelay(RunInfo, time.Duration)         {}
+func (NopHooks) OnMisfire(RunInfo)                      {}
+
+// hookList calls each of the hooks registered with a Cron in turn.
+type hookList []Hooks
+
+func (l hookList) OnSchedulerStart(now time.Time) {
+	for _, h := range l {
+		h.OnSchedulerStart(now)
+	}
+}
+
+func (l hookList) OnSchedulerStop(now time.Time) {
+	for _, h := range l {
+		h.OnSchedulerStop(now)
+	}
+}
+
+func (l hookList) OnScheduled(entry Entry) {
+	for _, h := range l {
+		h.OnScheduled(entry)
+	}
+}
+
+func (l hookList) OnRemoved(entry Entry) {
+	for _, h := range l {
+		h.OnRemoved(entry)
+	}
+}
+
+func (l hookList) OnJobStart(run RunInfo) {
+	for _, h := range l {
+		h.OnJobStart(run)
+	}
+}
+
+func (l hookList) OnJobEnd(run RunInfo, duration time.Duration, err error) {
+	for _, h := range l {
+		h.OnJobEnd(run, duration, err)
+	}
+}
+
+func (l hookList) OnPanic(run RunInfo, value any, stack []byte) {
+	for _, h := range l {
+		h.OnPanic(run, value, stack)
+	}
+}
+
+func (l hookList) OnSkip(run RunInfo) {
+	for _, h := range l {
+		h.OnSkip(run)
+	}
+}
+
+func (l hookList) OnDelay(run RunInfo, delay time.Duration) {
+	for _, h := range l {
+		h.OnDelay(run, delay)
+	}
+}
+
+func (l hookList) OnMisfire(run RunInfo) {
+	for _, h := range l {
+		h.OnMisfire(run)
+	}
+}
@@ -0,0 +1,245 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recordingHooks records the events it receives, one line each.
+type recordingHooks struct {
+	NopHooks
+	mu     sync.Mutex
+	events []string
+}
+
+func (h *recordingHooks) record(format string, args ...any) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = append(h.events, fmt.Sprintf(format, args...))
+}
+
+func (h *recordingHooks) Events() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return slices.Clone(h.events)
+}
+
+func (h *recordingHooks) OnSchedulerStart(time.Time) { h.record("start") }
+func (h *recordingHooks) OnSchedulerStop(time.Time)  { h.record("stop") }
+func (h *recordingHooks) OnScheduled(e Entry)        { h.record("scheduled %s", e.Name) }
+func (h *recordingHooks) OnRemoved(e Entry)          { h.record("removed %s", e.Name) }
+func (h *recordingHooks) OnJobStart(run RunInfo)     { h.record("job start %s", run.Name) }
+func (h *recordingHooks) OnSkip(run RunInfo)         { h.record("skip %s", run.Name) }
+func (h *recordingHooks) OnMisfire(run RunInfo) {
+	h.record("misfire %s", run.Scheduled.Format(time.Kitchen))
+}
+
+func (h *recordingHooks) OnJobEnd(run RunInfo, _ time.Duration, err error) {
+	h.record("job end %s: %v", run.Name, err)
+}
+
+func (h *recordingHooks) OnPanic(run RunInfo, value any, stack []byte) {
+	h.record("panic %s: %v", run.Name, value)
+}
+
+func (h *recordingHooks) OnDelay(run RunInfo, _ time.Duration) {
+	h.record("delay %s", run.Name)
+}
+
+func TestHooks(t *testing.T) {
+	hooks := &recordingHooks{}
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock), WithHooks(hooks))
+	sched, err := secondParser.Parse("* * * * * ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+
+	var runs int
+	id, err := cron.ScheduleContext(sched, func(context.Context) error {
+		runs++
+		if runs == 1 {
+			return errors.New("failed")
+		}
+		panic("YOLO")
+	}, EntryName("report"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock.AdvanceBy(2 * time.Second)
+	cron.Remove(id)
+	<-cron.Stop().Done()
+
+	want := []string{
+		"start",
+		"scheduled report",
+		"job start report",
+		"job end report: failed",
+		"job start report",
+		"panic report: YOLO",
+		"job end report: YOLO",
+		"removed report",
+		"stop",
+	}
+	if got := hooks.Events(); !slices.Equal(got, want) {
+		t.Errorf("expected events %q, got %q", want, got)
+	}
+}
+
+// The activations held back by the overlap policy are reported, whether they
+// are skipped or delayed.
+func TestHooksOverlap(t *testing.T) {
+	hooks := &recordingHooks{}
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock), WithHooks(hooks))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	release := make(chan struct{})
+	job := func() { <-release }
+	if _, err := cron.Schedule(sched, job, EntryName("skip"), EntrySkipIfRunning()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cron.Schedule(sched, job, EntryName("queue"), EntryQueueIfRunning()); err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	for _, name := range []string{"skip", "skip", "queue", "queue"} {
+		if _, err := cron.TriggerByName(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	close(release)
+	<-cron.Stop().Done()
+
+	events := hooks.Events()
+	if !slices.Contains(events, "skip skip") {
+		t.Errorf("expected the overlapping activation skipped, got %q", events)
+	}
+	if !slices.Contains(events, "delay queue") {
+		t.Errorf("expected the overlapping activation delayed, got %q", events)
+	}
+	if slices.Contains(events, "skip queue") || slices.Contains(events, "delay skip") {
+		t.Errorf("expected no other activation held back, got %q", events)
+	}
+}
+
+func TestHooksMisfire(t *testing.T) {
+	hooks := &recordingHooks{}
+	clock := newLateClock(start)
+	cron := New(WithClock(clock), WithHooks(hooks), WithMisfire(Misfire{Policy: MisfireSkip}))
+	if _, err := cron.Schedule(must(every(time.Minute)), func() {}); err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	clock.wakeAt(start.Add(2*time.Minute + 30*time.Second))
+	<-cron.Stop().Done()
+
+	want := []string{"scheduled ", "start", "misfire 7:01PM", "misfire 7:02PM", "stop"}
+	if got := hooks.Events(); !slices.Equal(got, want) {
+		t.Errorf("expected events %q, got %q", want, got)
+	}
+}
+
+// reentrantHooks calls back into its Cron from the hooks allowed to.
+type reentrantHooks struct {
+	NopHooks
+	cron  *Cron
+	calls atomic.Int32
+}
+
+func (h *reentrantHooks) call() {
+	h.cron.Entries()
+	h.calls.Add(1)
+}
+
+func (h *reentrantHooks) OnSchedulerStop(time.Time) { h.call() }
+func (h *reentrantHooks) OnScheduled(Entry)         { h.call() }
+func (h *reentrantHooks) OnRemoved(Entry)           { h.call() }
+
+// The hooks called outside of the scheduler goroutine may call the Cron,
+// whether it is running or not.
+func TestHooksReentrant(t *testing.T) {
+	hooks := &reentrantHooks{}
+	cron := New(WithHooks(hooks))
+	hooks.cron = cron
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopCompletesWithin(t, func() {
+		for _, running := range []bool{false, true} {
+			if running {
+				cron.Start()
+			}
+			id, err := cron.Schedule(sched, func() {})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			cron.Remove(id)
+		}
+		cron.Stop()
+	}, time.Second)
+	if n := hooks.calls.Load(); n != 5 {
+		t.Errorf("expected every hook to call the Cron, got %d calls", n)
+	}
+}
+
+func TestRunInfoLag(t *testing.T) {
+	run := RunInfo{Scheduled: start, Start: start.Add(time.Second)}
+	if lag := run.Lag(); lag != time.Second {
+		t.Errorf("expected 1s lag, got %v", lag)
+	}
+	if lag := (RunInfo{Start: start}).Lag(); lag != 0 {
+		t.Errorf("expected no lag for a triggered run, got %v", lag)
+	}
+	if lag := (RunInfo{Scheduled: start}).Lag(); lag != 0 {
+		t.Errorf("expected no lag for a run not started, got %v", lag)
+	}
+}
+
+// The job is given the description of its run through its context.
+func TestRunInfoFromContext(t *testing.T) {
+	if _, ok := RunInfoFromContext(context.Background()); ok {
+		t.Error("expected no run described by a plain context")
+	}
+
+	clock := newLateClock(start)
+	cron := New(WithClock(clock))
+	runs := make(chan RunInfo, 1)
+	id, err := cron.ScheduleContext(must(every(time.Minute)), func(ctx context.Context) error {
+		run, ok := RunInfoFromContext(ctx)
+		if !ok {
+			t.Error("expected the run described by its context")
+		}
+		runs <- run
+		return nil
+	}, EntryName("report"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	defer cron.Stop()
+
+	clock.wakeAt(start.Add(time.Minute + 500*time.Millisecond))
+	want := RunInfo{ID: id, Name: "report", Scheduled: start.Add(time.Minute), Start: start.Add(time.Minute + 500*time.Millisecond), Attempt: 1}
+	if run := <-runs; run != want {
+		t.Errorf("expected run %+v, got %+v", want, run)
+	}
+
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	if run := <-runs; !run.Scheduled.IsZero() || run.Attempt != 1 {
+		t.Errorf("expected a triggered run, got %+v", run)
+	}
+}
@@ -0,0 +1,211 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMetricsBuckets are the upper bounds, in seconds, of the histogram
+// buckets of [Metrics] unless configured otherwise.
+var DefaultMetricsBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 300, 900, 3600}
+
+// Metrics collects metrics about the runs of the jobs of a Cron, from the
+// events it is given as [Hooks], and serves them over HTTP in the Prometheus
+// text format:
+//
+//	m := cron.NewMetrics()
+//	c := cron.New(cron.WithHooks(m))
+//	http.Handle("/metrics", m)
+//
+// The series are labelled by job: the name of the entry, or else its ID.
+type Metrics struct {
+	NopHooks
+	mu      sync.Mutex
+	buckets []float64
+	jobs    map[string]*jobMetrics
+}
+
+// jobMetrics holds the metrics of a job.
+type jobMetrics struct {
+	succeeded uint64
+	failed    uint64
+	panicked  uint64
+	timedOut  uint64
+	skipped   uint64
+	delayed   uint64
+	misfired  uint64
+	running   int
+	duration  histogram
+	lag       histogram
+}
+
+// histogram counts observations in cumulative buckets.
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+func (h *histogram) observe(buckets []float64, v float64) {
+	if h.counts == nil {
+		h.counts = make([]uint64, len(buckets))
+	}
+	for i, bound := range buckets {
+		if v <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+// NewMetrics returns a collector whose histograms have buckets with the given
+// upper bounds in seconds, or DefaultMetricsBuckets if none is given.
+func NewMetrics(buckets ...float64) *Metrics {
+	if len(buckets) == 0 {
+		buckets = DefaultMetricsBuckets
+	}
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+	return &Metrics{buckets: buckets, jobs: map[string]*jobMetrics{}}
+}
+
+// job returns the metrics of the job of the run. The caller must hold mu.
+func (m *Metrics) job(run RunInfo) *jobMetrics {
+	name := run.Name
+	if name == "" {
+		name = strconv.FormatUint(uint64(run.ID), 10)
+	}
+	j, ok := m.jobs[name]
+	if !ok {
+		j = &jobMetrics{}
+		m.jobs[name] = j
+	}
+	return j
+}
+
+func (m *Metrics) OnJobStart(run RunInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	j := m.job(run)
+	j.running++
+	if !run.Scheduled.IsZero() && run.Attempt <= 1 {
+		j.lag.observe(m.buckets, run.Lag().Seconds())
+	}
+}
+
+func (m *Metrics) OnJobEnd(run RunInfo, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	j := m.job(run)
+	j.running--
+	if err != nil {
+		j.failed++
+		if errors.Is(err, ErrRunTimeout) {
+			j.timedOut++
+		}
+	} else {
+		j.succeeded++
+	}
+	j.duration.observe(m.buckets, duration.Seconds())
+}
+
+func (m *Metrics) OnPanic(run RunInfo, _ any, _ []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.job(run).panicked++
+}
+
+func (m *Metrics) OnSkip(run RunInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.job(run).skipped++
+}
+
+func (m *Metrics) OnDelay(run RunInfo, _ time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.job(run).delayed++
+}
+
+func (m *Metrics) OnMisfire(run RunInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.job(run).misfired++
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics to w in the Prometheus text format.
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	names := make([]string, 0, len(m.jobs))
+	for name := range m.jobs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var b strings.Builder
+	counter := func(metric, help string, value func(*jobMetrics) uint64) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s counter\n", metric, help, metric)
+		for _, name := range names {
+			fmt.Fprintf(&b, "%s{job=%s} %d\n", metric, quoteLabel(name), value(m.jobs[name]))
+		}
+	}
+	histo := func(metric, help string, value func(*jobMetrics) *histogram) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s histogram\n", metric, help, metric)
+		for _, name := range names {
+			h, job := value(m.jobs[name]), quoteLabel(name)
+			for i, bound := range m.buckets {
+				var count uint64
+				if h.counts != nil {
+					count = h.counts[i]
+				}
+				fmt.Fprintf(&b, "%s_bucket{job=%s,le=\"%s\"} %d\n", metric, job, strconv.FormatFloat(bound, 'g', -1, 64), count)
+			}
+			fmt.Fprintf(&b, "%s_bucket{job=%s,le=\"+Inf\"} %d\n", metric, job, h.count)
+			fmt.Fprintf(&b, "%s_sum{job=%s} %s\n", metric, job, strconv.FormatFloat(h.sum, 'g', -1, 64))
+			fmt.Fprintf(&b, "%s_count{job=%s} %d\n", metric, job, h.count)
+		}
+	}
+
+	fmt.Fprintf(&b, "# HELP cron_job_runs_total Runs of the job completed, by outcome.\n# TYPE cron_job_runs_total counter\n")
+	for _, name := range names {
+		j := m.jobs[name]
+		fmt.Fprintf(&b, "cron_job_runs_total{job=%s,outcome=\"success\"} %d\n", quoteLabel(name), j.succeeded)
+		fmt.Fprintf(&b, "cron_job_runs_total{job=%s,outcome=\"error\"} %d\n", quoteLabel(name), j.failed)
+	}
+	counter("cron_job_panics_total", "Runs of the job that panicked.", func(j *jobMetrics) uint64 { return j.panicked })
+	counter("cron_job_timeouts_total", "Runs of the job that outlasted their timeout.", func(j *jobMetrics) uint64 { return j.timedOut })
+	counter("cron_job_skipped_total", "Activations of the job skipped by its overlap policy.", func(j *jobMetrics) uint64 { return j.skipped })
+	counter("cron_job_delayed_total", "Activations of the job delayed by its overlap policy.", func(j *jobMetrics) uint64 { return j.delayed })
+	counter("cron_job_misfires_total", "Activations of the job missed by the scheduler.", func(j *jobMetrics) uint64 { return j.misfired })
+	fmt.Fprintf(&b, "# HELP cron_job_running Runs of the job in progress.\n# TYPE cron_job_running gauge\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "cron_job_running{job=%s} %d\n", quoteLabel(name), m.jobs[name].running)
+	}
+	histo("cron_job_duration_seconds", "Duration of the runs of the job.", func(j *jobMetrics) *histogram { return &j.duration })
+	histo("cron_job_lag_seconds", "Delay between the activation time of the scheduled runs of the job and their start.", func(j *jobMetrics) *histogram { return &j.lag })
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// quoteLabel quotes a label value, escaping it as the Prometheus text format
+// requires.
+func quoteLabel(v string) string {
+	v = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+	return `"` + v + `"`
+}
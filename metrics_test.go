@@ -0,0 +1,90 @@
+package cron
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics(1, 10)
+	run := RunInfo{ID: 1, Name: "report", Scheduled: start, Start: start.Add(2 * time.Second)}
+	m.OnJobStart(run)
+	m.OnJobEnd(run, 500*time.Millisecond, nil)
+	m.OnJobStart(run)
+	m.OnPanic(run, "YOLO", nil)
+	m.OnJobEnd(run, 20*time.Second, errors.New("YOLO"))
+	m.OnJobStart(run)
+	m.OnJobEnd(run, time.Minute, ErrRunTimeout)
+	m.OnSkip(run)
+	m.OnDelay(run, time.Second)
+	m.OnMisfire(run)
+	// A triggered run of an entry without a name.
+	triggered := RunInfo{ID: 2, Start: start}
+	m.OnJobStart(triggered)
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("expected the Prometheus text format, got %q", ct)
+	}
+	body := rec.Body.String()
+	for _, line := range []string{
+		"# TYPE cron_job_runs_total counter",
+		`cron_job_runs_total{job="report",outcome="success"} 1`,
+		`cron_job_runs_total{job="report",outcome="error"} 2`,
+		`cron_job_timeouts_total{job="report"} 1`,
+		`cron_job_panics_total{job="report"} 1`,
+		`cron_job_skipped_total{job="report"} 1`,
+		`cron_job_delayed_total{job="report"} 1`,
+		`cron_job_misfires_total{job="report"} 1`,
+		`cron_job_running{job="report"} 0`,
+		`cron_job_running{job="2"} 1`,
+		"# TYPE cron_job_duration_seconds histogram",
+		`cron_job_duration_seconds_bucket{job="report",le="1"} 1`,
+		`cron_job_duration_seconds_bucket{job="report",le="10"} 1`,
+		`cron_job_duration_seconds_bucket{job="report",le="+Inf"} 3`,
+		`cron_job_duration_seconds_sum{job="report"} 80.5`,
+		`cron_job_duration_seconds_count{job="report"} 3`,
+		`cron_job_lag_seconds_bucket{job="report",le="1"} 0`,
+		`cron_job_lag_seconds_bucket{job="report",le="10"} 3`,
+		`cron_job_lag_seconds_count{job="2"} 0`,
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("expected line %q, got:\n%s", line, body)
+		}
+	}
+}
+
+func TestMetricsQuoteLabel(t *testing.T) {
+	if got, want := quoteLabel("a\"b\\c\nd"), `"a\"b\\c\nd"`; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+// Metrics registered as hooks are fed by the scheduler.
+func TestMetricsHooks(t *testing.T) {
+	m := NewMetrics()
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock), WithHooks(m))
+	sched, err := secondParser.Parse("* * * * * ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cron.Schedule(sched, func() {}, EntryName("report")); err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	clock.AdvanceBy(3 * time.Second)
+	<-cron.Stop().Done()
+
+	var b strings.Builder
+	if _, err := m.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	if line := `cron_job_runs_total{job="report",outcome="success"} 3`; !strings.Contains(b.String(), line) {
+		t.Errorf("expected line %q, got:\n%s", line, b.String())
+	}
+}
@@ -0,0 +1,111 @@
+package cron
+
+import "time"
+
+// DefaultMisfireTolerance is how late an activation may be started before it
+// counts as missed, unless configured otherwise.
+const DefaultMisfireTolerance = time.Second
+
+// maxMisfires bounds the missed activations of an entry sorted out at a single
+// wake-up, so that a long stall of a frequent schedule cannot hold up the
+// scheduler: the ones beyond are neither reported nor run.
+const maxMisfires = 1000
+
+// MisfirePolicy tells what becomes of the activations of an entry that the
+// scheduler missed, having woken up too late to start them on time: after a
+// GC pause, a suspended host or a stalled scheduler.
+type MisfirePolicy int
+
+const (
+	// MisfireFireOnce runs the job once in place of all the missed
+	// activations, for the latest of them.
+	MisfireFireOnce MisfirePolicy = iota
+	// MisfireFireAll runs the job once for each missed activation, oldest
+	// first, up to the cap.
+	MisfireFireAll
+	// MisfireSkip runs the job for none of the missed activations.
+	MisfireSkip
+)
+
+func (p MisfirePolicy) String() string {
+	switch p {
+	case MisfireFireOnce:
+		return "fire once"
+	case MisfireFireAll:
+		return "fire all"
+	case MisfireSkip:
+		return "skip"
+	default:
+		return "unknown"
+	}
+}
+
+// Misfire configures the handling of missed activations.
+//
+// An activation is missed when the scheduler starts handling it more than
+// Tolerance after its time. Each missed activation is logged, whatever the
+// policy; an activation on time is always run. Activations coming due by the
+// same wake-up and all on time are run once, like a single one.
+type Misfire struct {
+	// Policy tells what becomes of the missed activations.
+	Policy MisfirePolicy
+
+	// Tolerance is how late an activation may be started before it counts as
+	// missed, like the startingDeadlineSeconds of a Kubernetes CronJob. Zero
+	// means DefaultMisfireTolerance.
+	Tolerance time.Duration
+
+	// Cap bounds the runs of the MisfireFireAll policy at a single wake-up,
+	// zero meaning no bound.
+	Cap int
+}
+
+func (m Misfire) tolerance() time.Duration {
+	if m.Tolerance <= 0 {
+		return DefaultMisfireTolerance
+	}
+	return m.Tolerance
+}
+
+// due sorts out the activations of e due by now, from e.Next on: it returns
+// the missed ones, oldest first, and the latest of the ones on time, zero if
+// none is.
+func (e *Entry) due(now time.Time) (missed []time.Time, onTime time.Time) {
+	tolerance := e.Misfire.tolerance()
+	t := e.Next
+	for !t.IsZero() && !t.After(now) {
+		switch {
+		case now.Sub(t) <= tolerance:
+			onTime = t
+		case len(missed) < maxMisfires:
+			missed = append(missed, t)
+		default:
+			// Jump straight to the activations on time, if any.
+			t = e.Schedule.Next(now.Add(-tolerance))
+			continue
+		}
+		t = e.Schedule.Next(t)
+	}
+	return missed, onTime
+}
+
+// runs returns the activations to run out of the due ones, oldest first,
+// according to the policy.
+func (m Misfire) runs(missed []time.Time, onTime time.Time) []time.Time {
+	var runs []time.Time
+	switch m.Policy {
+	case MisfireFireAll:
+		if m.Cap > 0 && len(missed) > m.Cap {
+			missed = missed[:m.Cap]
+		}
+		runs = append(runs, missed...)
+	case MisfireFireOnce:
+		if onTime.IsZero() && len(missed) > 0 {
+			runs = missed[len(missed)-1:]
+		}
+	}
+	if !onTime.IsZero() {
+		runs = append(runs, onTime)
+	}
+	return runs
+}
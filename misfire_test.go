@@ -0,0 +1,161 @@
+package cron
+
+import (
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEntryDue(t *testing.T) {
+	e := &Entry{Schedule: must(every(time.Minute)), Next: start}
+
+	missed, onTime := e.due(start.Add(500 * time.Millisecond))
+	if len(missed) != 0 || !onTime.Equal(start) {
+		t.Errorf("expected a single activation on time, got %v and %v", missed, onTime)
+	}
+
+	missed, onTime = e.due(start.Add(150 * time.Second))
+	if len(missed) != 3 || !missed[0].Equal(start) || !missed[2].Equal(start.Add(2*time.Minute)) || !onTime.IsZero() {
+		t.Errorf("expected 3 missed activations and none on time, got %v and %v", missed, onTime)
+	}
+
+	e.Misfire.Tolerance = time.Minute
+	missed, onTime = e.due(start.Add(150 * time.Second))
+	if len(missed) != 2 || !onTime.Equal(start.Add(2*time.Minute)) {
+		t.Errorf("expected 2 missed activations and the last one on time, got %v and %v", missed, onTime)
+	}
+}
+
+// A stall spanning more activations than maxMisfires reports maxMisfires of
+// them, still finding the ones on time.
+func TestEntryDueBounded(t *testing.T) {
+	e := &Entry{Schedule: must(every(time.Second)), Next: start}
+	now := start.Add(2 * maxMisfires * time.Second)
+	missed, onTime := e.due(now)
+	if len(missed) != maxMisfires {
+		t.Errorf("expected %d missed activations, got %d", maxMisfires, len(missed))
+	}
+	if !onTime.Equal(now) {
+		t.Errorf("expected the activation at %v on time, got %v", now, onTime)
+	}
+}
+
+func TestMisfireRuns(t *testing.T) {
+	missed := []time.Time{start, start.Add(time.Minute), start.Add(2 * time.Minute)}
+	onTime := start.Add(3 * time.Minute)
+	cases := []struct {
+		name   string
+		policy Misfire
+		onTime time.Time
+		want   []time.Time
+	}{
+		{"fire once", Misfire{}, time.Time{}, missed[2:]},
+		{"fire once with one on time", Misfire{}, onTime, []time.Time{onTime}},
+		{"fire all", Misfire{Policy: MisfireFireAll}, onTime, append(missed[:3:3], onTime)},
+		{"fire all capped", Misfire{Policy: MisfireFireAll, Cap: 2}, time.Time{}, missed[:2]},
+		{"skip", Misfire{Policy: MisfireSkip}, time.Time{}, nil},
+		{"skip with one on time", Misfire{Policy: MisfireSkip}, onTime, []time.Time{onTime}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runs := tc.policy.runs(missed, tc.onTime)
+			if len(runs) != len(tc.want) {
+				t.Fatalf("expected runs %v, got %v", tc.want, runs)
+			}
+			for i := range runs {
+				if !runs[i].Equal(tc.want[i]) {
+					t.Fatalf("expected runs %v, got %v", tc.want, runs)
+				}
+			}
+		})
+	}
+}
+
+// lateClock is a manual clock whose timers are fired by the test, at whatever
+// time it sets, so as to wake the scheduler up late.
+type lateClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	armed chan chan struct{}
+}
+
+func newLateClock(now time.Time) *lateClock {
+	return &lateClock{now: now, armed: make(chan chan struct{}, 100)}
+}
+
+func (c *lateClock) Register(*Cron) []Option { return nil }
+
+func (c *lateClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *lateClock) Timer(time.Time) (<-chan struct{}, func()) {
+	timer := make(chan struct{}, 1)
+	c.armed <- timer
+	return timer, func() {}
+}
+
+func (c *lateClock) NopTimer() (<-chan struct{}, func()) {
+	return c.Timer(time.Time{})
+}
+
+// wakeAt fires the timer the scheduler is sleeping on, at now, and waits for
+// it to go back to sleep.
+func (c *lateClock) wakeAt(now time.Time) {
+	timer := <-c.armed
+	c.mu.Lock()
+	c.now = now
+	c.mu.Unlock()
+	timer <- struct{}{}
+	c.armed <- <-c.armed
+}
+
+// Activations missed by a late wake-up are handled according to the misfire
+// policy of each entry, and logged.
+func TestMisfire(t *testing.T) {
+	var buf syncWriter
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	clock := newLateClock(start)
+	cron := New(WithClock(clock), WithLogger(logger), WithMisfire(Misfire{Policy: MisfireFireAll, Cap: 2}))
+	sched := must(every(time.Minute))
+
+	var once, all, skip atomic.Int32
+	if _, err := cron.Schedule(sched, func() { once.Add(1) }, EntryMisfire(Misfire{})); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cron.Schedule(sched, func() { all.Add(1) }); err != nil {
+		t.Fatal(err)
+	}
+	skipID, err := cron.Schedule(sched, func() { skip.Add(1) }, EntryMisfire(Misfire{Policy: MisfireSkip}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+
+	// The activations at +1m, +2m and +3m are all missed by the first wake-up,
+	// the next one then comes a minute after it.
+	clock.wakeAt(start.Add(3*time.Minute + 30*time.Second))
+	clock.wakeAt(start.Add(4*time.Minute + 30*time.Second))
+	<-cron.Stop().Done()
+
+	if n := once.Load(); n != 2 {
+		t.Errorf("expected the missed activations fired once, then the one on time, got %d runs", n)
+	}
+	if n := all.Load(); n != 3 {
+		t.Errorf("expected 2 of the missed activations fired, then the one on time, got %d runs", n)
+	}
+	if n := skip.Load(); n != 1 {
+		t.Errorf("expected only the activation on time fired, got %d runs", n)
+	}
+	if prev := cron.Entry(skipID).Prev; !prev.Equal(start.Add(4*time.Minute + 30*time.Second)) {
+		t.Errorf("expected Prev at the last activation run, got %v", prev)
+	}
+	if n := strings.Count(buf.String(), "event=misfire"); n != 9 {
+		t.Errorf("expected every missed activation of every entry logged, got %d", n)
+	}
+}
@@ -2,7 +2,6 @@ package cron
 
 import (
 	"log/slog"
-	"sync"
 	"time"
 )
 
@@ -26,6 +25,119 @@ func WithClock(clock Clock) Option {
 	}
 }
 
+// WithWorkerPool bounds the number of jobs running at once across all the
+// entries of the Cron to size workers, zero meaning no bound. The activations
+// beyond it wait for a worker in the order they came due, the time they wait
+// being reported by Entry.LastWait; [Cron.Stats] reports the load of the pool.
+//
+// Waiting for a worker comes after the overlap policy of the entry: an
+// activation skipped by it never takes a worker.
+func WithWorkerPool(size int) Option {
+	return func(c *Cron) {
+		c.pool = &workerPool{size: max(size, 0)}
+	}
+}
+
+// WithMisfire sets how the activations missed by the scheduler are handled,
+// when it wakes up too late to start them on time. By default, the job is run
+// once in place of all of them. Entries may override it, see [EntryOption].
+func WithMisfire(misfire Misfire) Option {
+	return func(c *Cron) {
+		c.misfire = misfire
+	}
+}
+
+// WithRestartPolicy sets what becomes of the activations missed while the
+// scheduler was stopped, when it is started again. It defaults to
+// RestartResume.
+func WithRestartPolicy(policy RestartPolicy) Option {
+	return func(c *Cron) {
+		c.restart = policy
+	}
+}
+
+// WithStore persists the state of the entries given a name (see [EntryName])
+// to store, so that it survives restarts of the process. An entry scheduled
+// with the name of a saved state gets its Prev time and last outcome back, and
+// its first activation is the one following that Prev time: the activations
+// missed while the process was down are then handled by the misfire policy.
+func WithStore(store Store) Option {
+	return func(c *Cron) {
+		c.store = store
+	}
+}
+
+// WithLagThreshold logs a warning for each scheduled run starting more than
+// threshold after its activation time, see [RunInfo.Lag]. A lag growing across
+// runs hints at an overloaded host or a misbehaving timer. Zero, the default,
+// disables the warning.
+func WithLagThreshold(threshold time.Duration) Option {
+	return func(c *Cron) {
+		c.lagThreshold = threshold
+	}
+}
+
+// WithHistorySize sets the number of completed runs retained for each entry,
+// see [Cron.History]. It defaults to DefaultHistorySize; zero retains none.
+func WithHistorySize(size int) Option {
+	return func(c *Cron) {
+		c.historySize = max(size, 0)
+	}
+}
+
+// WithHooks registers hooks receiving the lifecycle events of the Cron, of its
+// entries and of their runs. Hooks registered by several options are called in
+// the order they were given.
+func WithHooks(hooks Hooks) Option {
+	return func(c *Cron) {
+		c.hooks = append(c.hooks, hooks)
+	}
+}
+
+// WithChain wraps the jobs of every entry with the given wrappers, the first
+// given being the outermost. Wrappers given by several options are chained in
+// the order they were given, and wrap the ones of the entries, see
+// [EntryChain].
+func WithChain(wrappers ...JobWrapper) Option {
+	return func(c *Cron) {
+		c.chain = append(c.chain, wrappers...)
+	}
+}
+
+// WithPanicPolicy sets how the runs whose job panicked are handled, besides
+// being recovered from and logged. Entries may override it, see
+// [EntryOption].
+func WithPanicPolicy(policy PanicPolicy) Option {
+	return func(c *Cron) {
+		c.panicPolicy = policy
+	}
+}
+
+// WithRunTimeout bounds the runs of the jobs, cancelling their context at the
+// timeout and optionally abandoning those whose job ignores it. By default,
+// runs are not bounded. Entries may override it, see [EntryOption].
+func WithRunTimeout(timeout RunTimeout) Option {
+	return func(c *Cron) {
+		c.runTimeout = timeout
+	}
+}
+
+// WithShutdownGrace sets how long [Cron.Shutdown] waits for the running jobs
+// once it has cancelled their context. It defaults to DefaultShutdownGrace.
+func WithShutdownGrace(grace time.Duration) Option {
+	return func(c *Cron) {
+		c.shutdownGrace = grace
+	}
+}
+
+// WithRetry sets how the failed runs of the jobs are retried. By default, they
+// are not. Entries may override it, see [EntryOption].
+func WithRetry(retry RetryPolicy) Option {
+	return func(c *Cron) {
+		c.retry = retry
+	}
+}
+
 // WithOnCycleCompleted registers a callback that will be executed every time all jobs executions that
 // have been started in the same instant have completed.
 func WithOnCycleCompleted(f func()) Option {
@@ -38,23 +150,13 @@ func WithOnCycleCompleted(f func()) Option {
 // the same job is still in progress.
 //
 // The guard is per job, so distinct jobs never hold each other up. It is
-// mutually exclusive with [WithQueueIfRunning]: giving both leaves the last
-// one in effect. With neither, successive runs of the same job may overlap.
+// mutually exclusive with [WithQueueIfRunning], [WithReplaceIfRunning] and
+// [WithMaxConcurrentRuns]: giving several leaves the last one in effect. With
+// none, successive runs of the same job may overlap. Entries may override it,
+// see [EntryOption].
 func WithSkipIfRunning() Option {
 	return func(c *Cron) {
-		c.overlap = func(cmd func(), logger *slog.Logger) func() {
-			var ch = make(chan struct{}, 1)
-			ch <- struct{}{}
-			return func() {
-				select {
-				case v := <-ch:
-					defer func() { ch <- v }()
-					cmd()
-				default:
-					logger.Info("job execution skipped", "event", "skip")
-				}
-			}
-		}
+		c.overlap, c.maxRuns, c.maxQueued = OverlapSkip, 1, 0
 	}
 }
 
@@ -62,21 +164,43 @@ func WithSkipIfRunning() Option {
 // run of the same job is still in progress, running it once that one completes.
 //
 // The guard is per job, so distinct jobs never hold each other up. It is
-// mutually exclusive with [WithSkipIfRunning]: giving both leaves the last one
-// in effect. With neither, successive runs of the same job may overlap.
+// mutually exclusive with [WithSkipIfRunning], [WithReplaceIfRunning] and
+// [WithMaxConcurrentRuns]: giving several leaves the last one in effect. With
+// none, successive runs of the same job may overlap. Entries may override it,
+// see [EntryOption].
 func WithQueueIfRunning() Option {
 	return func(c *Cron) {
-		c.overlap = func(cmd func(), logger *slog.Logger) func() {
-			var mu sync.Mutex
-			return func() {
-				start := time.Now()
-				mu.Lock()
-				defer mu.Unlock()
-				if dur := time.Since(start); dur > time.Minute {
-					logger.Info("job execution delayed", "event", "delay", "duration", dur)
-				}
-				cmd()
-			}
-		}
+		c.overlap, c.maxRuns, c.maxQueued = OverlapQueue, 1, 0
+	}
+}
+
+// WithReplaceIfRunning cancels the context of the previous run of the same job
+// when an activation comes due while it is still in progress, starting the new
+// run once the previous one returns. Should it fail to return within a minute,
+// the new run is started anyway.
+//
+// The guard is per job, so distinct jobs never hold each other up. It is
+// mutually exclusive with [WithSkipIfRunning], [WithQueueIfRunning] and
+// [WithMaxConcurrentRuns]: giving several leaves the last one in effect. With
+// none, successive runs of the same job may overlap. Entries may override it,
+// see [EntryOption].
+func WithReplaceIfRunning() Option {
+	return func(c *Cron) {
+		c.overlap, c.maxRuns, c.maxQueued = OverlapReplace, 1, 0
+	}
+}
+
+// WithMaxConcurrentRuns lets up to n runs of the same job be in progress at
+// once, n being at least one; whenFull tells what becomes of an activation
+// coming due while n runs are. With QueueWhenFull, the queued activations are
+// bounded too, so that a hung job cannot pile them up without limit.
+//
+// The guard is per job, so distinct jobs never hold each other up. It is
+// mutually exclusive with [WithSkipIfRunning], [WithQueueIfRunning] and
+// [WithReplaceIfRunning], which amount to a single run at once: giving several
+// leaves the last one in effect. Entries may override it, see [EntryOption].
+func WithMaxConcurrentRuns(n int, whenFull Policy) Option {
+	return func(c *Cron) {
+		c.overlap, c.maxRuns, c.maxQueued = whenFull.overlap(), max(n, 1), whenFull.depth
 	}
 }
@@ -1,6 +1,7 @@
 package cron
 
 import (
+	"context"
 	"log/slog"
 	"strings"
 	"sync"
@@ -175,3 +176,217 @@ func TestWithQueueIfRunning(t *testing.T) {
 		t.Errorf("expected second execution queued until the first completed (~%v later), started %v later", jobDuration, gap)
 	}
 }
+
+// An activation coming due while the previous run of the same job is still in
+// progress cancels it when the cron is configured with WithReplaceIfRunning,
+// starting once it returns.
+func TestWithReplaceIfRunning(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewTimerSkippingRealExecutionClock(start)
+	crn := New(WithClock(clock), WithReplaceIfRunning())
+
+	// Exactly two activations, one second apart: 00:00:01 and 00:00:02.
+	sched, err := secondParser.Parse("1,2 0 0 1 1 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	var outcomes []error
+	if _, err := crn.ScheduleContext(sched, func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+		case <-time.After(1500 * time.Millisecond): // spans the second activation
+		}
+		mu.Lock()
+		outcomes = append(outcomes, ctx.Err())
+		mu.Unlock()
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	crn.Start()
+	defer crn.Stop()
+
+	clock.AdvanceBy(2 * time.Second)
+	clock.WaitForIdle()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(outcomes) != 2 {
+		t.Fatalf("expected 2 executions, got %d", len(outcomes))
+	}
+	if outcomes[0] == nil {
+		t.Error("expected the first execution cancelled by the second activation")
+	}
+	if outcomes[1] != nil {
+		t.Errorf("expected the replacing execution to complete, got %v", outcomes[1])
+	}
+}
+
+// Up to n runs of the same job are started at once with WithMaxConcurrentRuns,
+// the activations beyond being queued up to the given depth.
+func TestWithMaxConcurrentRuns(t *testing.T) {
+	crn := New(WithMaxConcurrentRuns(2, QueueWhenFull(1)))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var runs atomic.Int32
+	release := make(chan struct{})
+	id, err := crn.Schedule(sched, func() {
+		runs.Add(1)
+		<-release
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	crn.Start()
+
+	for i, want := range []Dispatch{DispatchStarted, DispatchStarted, DispatchQueued, DispatchSkipped} {
+		if dispatch, err := crn.Trigger(id); err != nil || dispatch != want {
+			t.Errorf("expected activation %d %v, got %v, %v", i+1, want, dispatch, err)
+		}
+	}
+	close(release)
+	<-crn.Stop().Done()
+	if n := runs.Load(); n != 3 {
+		t.Errorf("expected 3 runs, got %d", n)
+	}
+}
+
+// No more jobs than the pool size run at once with WithWorkerPool, the others
+// waiting for a worker.
+func TestWithWorkerPool(t *testing.T) {
+	crn := New(WithWorkerPool(1))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var running, ran atomic.Int32
+	var overlapped atomic.Bool
+	release := make(chan struct{})
+	var ids []ID
+	for i := 0; i < 3; i++ {
+		id, err := crn.Schedule(sched, func() {
+			if running.Add(1) > 1 {
+				overlapped.Store(true)
+			}
+			<-release
+			running.Add(-1)
+			ran.Add(1)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	crn.Start()
+
+	for _, id := range ids {
+		if _, err := crn.Trigger(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	deadline := time.Now().Add(time.Second)
+	for crn.Stats().Queued != 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if s := crn.Stats(); s.Workers != 1 || s.Busy != 1 || s.Queued != 2 {
+		t.Errorf("expected 1 busy worker and 2 queued activations, got %+v", s)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	waitFor(t, func() bool { return ran.Load() == 3 })
+	<-crn.Stop().Done()
+	if overlapped.Load() {
+		t.Error("expected a single job running at once")
+	}
+	if wait := crn.Entry(ids[2]).LastWait; wait < 10*time.Millisecond {
+		t.Errorf("expected the last activation to wait for a worker, waited %v", wait)
+	}
+}
+
+// The activations still waiting for a worker once the scheduler is stopped
+// are skipped rather than run.
+func TestWithWorkerPoolStop(t *testing.T) {
+	hooks := &recordingHooks{}
+	crn := New(WithWorkerPool(1), WithHooks(hooks))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ran atomic.Int32
+	release := make(chan struct{})
+	var ids []ID
+	for i := 0; i < 3; i++ {
+		id, err := crn.Schedule(sched, func() {
+			ran.Add(1)
+			<-release
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	crn.Start()
+	for _, id := range ids {
+		if _, err := crn.Trigger(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitFor(t, func() bool { return crn.Stats().Queued == 2 })
+
+	done := crn.Stop()
+	waitFor(t, func() bool { return crn.Stats().Queued == 0 })
+	close(release)
+	<-done.Done()
+	if n := ran.Load(); n != 1 {
+		t.Errorf("expected only the activation running when stopped run, got %d runs", n)
+	}
+	if s := crn.Stats(); s.Busy != 0 {
+		t.Errorf("expected no busy worker left, got %+v", s)
+	}
+	var skipped int
+	for _, event := range hooks.Events() {
+		if strings.HasPrefix(event, "skip") {
+			skipped++
+		}
+	}
+	if skipped != 2 {
+		t.Errorf("expected the 2 skipped activations reported, got %q", hooks.Events())
+	}
+}
+
+// A run starting later than the threshold after its activation time is logged,
+// and its lag reported on the entry.
+func TestWithLagThreshold(t *testing.T) {
+	var buf syncWriter
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	clock := newLateClock(start)
+	cron := New(WithClock(clock), WithLogger(logger), WithLagThreshold(10*time.Second))
+	ran := make(chan struct{})
+	id, err := cron.Schedule(must(every(time.Minute)), func() { ran <- struct{}{} })
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	clock.wakeAt(start.Add(time.Minute + 5*time.Second))
+	<-ran
+	clock.wakeAt(start.Add(2*time.Minute + 30*time.Second))
+	<-ran
+	<-cron.Stop().Done()
+
+	if n := strings.Count(buf.String(), "event=lag"); n != 1 {
+		t.Errorf("expected only the run 25s late logged, got %d", n)
+	}
+	entry := cron.Entry(id)
+	if want := start.Add(2*time.Minute + 5*time.Second); !entry.LastScheduled.Equal(want) {
+		t.Errorf("expected last run scheduled at %v, got %v", want, entry.LastScheduled)
+	}
+	if entry.LastLag != 25*time.Second {
+		t.Errorf("expected last run 25s late, got %v", entry.LastLag)
+	}
+}
@@ -0,0 +1,226 @@
+package cron
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// replaceTimeout bounds the wait of a replacing run for the run it cancelled to
+// return: past it, the replacing run starts anyway.
+const replaceTimeout = time.Minute
+
+// OverlapPolicy tells how an activation is handled when it comes due while a
+// previous run of the same entry is still in progress.
+type OverlapPolicy int
+
+const (
+	// OverlapAllow lets successive runs of the same entry overlap.
+	OverlapAllow OverlapPolicy = iota
+	// OverlapSkip skips the activation.
+	OverlapSkip
+	// OverlapQueue defers the activation until the previous run completes.
+	OverlapQueue
+	// OverlapReplace cancels the context of the previous run, starting the
+	// activation once it returns.
+	OverlapReplace
+)
+
+func (p OverlapPolicy) String() string {
+	switch p {
+	case OverlapAllow:
+		return "allow"
+	case OverlapSkip:
+		return "skip"
+	case OverlapQueue:
+		return "queue"
+	case OverlapReplace:
+		return "replace"
+	default:
+		return "unknown"
+	}
+}
+
+// Policy tells what becomes of an activation coming due while its entry has as
+// many runs in progress as it allows, see [WithMaxConcurrentRuns].
+type Policy struct {
+	queue bool
+	depth int
+}
+
+// SkipWhenFull skips the activation.
+func SkipWhenFull() Policy {
+	return Policy{}
+}
+
+// QueueWhenFull defers the activation until a run in progress completes,
+// keeping at most depth activations waiting: the ones coming due beyond it are
+// skipped. A depth of zero means no bound.
+func QueueWhenFull(depth int) Policy {
+	return Policy{queue: true, depth: max(depth, 0)}
+}
+
+// overlap returns the overlap policy applied to the activations beyond the
+// limit.
+func (p Policy) overlap() OverlapPolicy {
+	if p.queue {
+		return OverlapQueue
+	}
+	return OverlapSkip
+}
+
+// Dispatch tells what became of an activation handed over to the overlap
+// policy of its entry.
+type Dispatch int
+
+const (
+	// DispatchStarted means the job was started right away.
+	DispatchStarted Dispatch = iota
+	// DispatchQueued means the job will be started once a run in progress
+	// completes.
+	DispatchQueued
+	// DispatchSkipped means the job was not started, because a run was
+	// already in progress.
+	DispatchSkipped
+	// DispatchReplaced means the run in progress was cancelled, the job being
+	// started once it returns.
+	DispatchReplaced
+)
+
+func (d Dispatch) String() string {
+	switch d {
+	case DispatchStarted:
+		return "started"
+	case DispatchQueued:
+		return "queued"
+	case DispatchSkipped:
+		return "skipped"
+	case DispatchReplaced:
+		return "replaced"
+	default:
+		return "unknown"
+	}
+}
+
+// overlapGuard enforces the overlap policy of a single entry, keeping track of
+// its runs in progress. Activations beyond the limit are skipped, queued or
+// replace the runs in progress; queued ones are started in the order they came
+// due.
+type overlapGuard struct {
+	mu sync.Mutex
+	// limit is the number of runs allowed in progress at once, zero meaning no
+	// limit; whenFull tells what becomes of the activations beyond it, and
+	// depth bounds the queued ones, zero meaning no bound.
+	limit    int
+	whenFull OverlapPolicy
+	depth    int
+	// timeout bounds the wait of replacing activations.
+	timeout time.Duration
+	running int
+	// admitted holds every activation not skipped until its run is over,
+	// waiting ones included, while waiting holds the latter in order.
+	admitted map[*ticket]struct{}
+	waiting  []*ticket
+}
+
+// ticket is an activation admitted by an overlapGuard.
+type ticket struct {
+	dispatch Dispatch
+	cancel   context.CancelFunc
+	// ready is closed once a waiting activation may start; nil if it started
+	// right away.
+	ready chan struct{}
+}
+
+// newOverlapGuard returns a guard enforcing the given policy, allowing limit
+// runs in progress at once and depth queued activations.
+func newOverlapGuard(policy OverlapPolicy, limit, depth int) *overlapGuard {
+	g := &overlapGuard{admitted: map[*ticket]struct{}{}}
+	if policy == OverlapAllow {
+		return g
+	}
+	g.limit = max(limit, 1)
+	g.whenFull = policy
+	g.depth = depth
+	if policy == OverlapReplace {
+		g.timeout = replaceTimeout
+	}
+	return g
+}
+
+// admit decides the fate of an activation, whose run can be cancelled through
+// cancel. It returns a nil ticket for a skipped activation; every other one
+// must await its ticket before starting, and release it once its run is over.
+func (g *overlapGuard) admit(cancel context.CancelFunc) (Dispatch, *ticket) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	t := &ticket{dispatch: DispatchStarted, cancel: cancel}
+	switch {
+	case g.limit == 0 || g.running < g.limit:
+		g.running++
+	case g.whenFull == OverlapQueue && (g.depth == 0 || len(g.waiting) < g.depth):
+		t.dispatch = DispatchQueued
+	case g.whenFull == OverlapReplace:
+		// Every activation admitted so far is superseded, those still waiting
+		// included: they are cancelled before they even start.
+		for other := range g.admitted {
+			other.cancel()
+		}
+		t.dispatch = DispatchReplaced
+	default:
+		return DispatchSkipped, nil
+	}
+	if t.dispatch != DispatchStarted {
+		t.ready = make(chan struct{})
+		g.waiting = append(g.waiting, t)
+	}
+	g.admitted[t] = struct{}{}
+	return t.dispatch, t
+}
+
+// await blocks until the activation of t may start. A replacing activation
+// stops waiting after the guard's timeout, starting anyway; await reports
+// whether that happened.
+func (g *overlapGuard) await(t *ticket) (forced bool) {
+	if t.ready == nil {
+		return false
+	}
+	if t.dispatch != DispatchReplaced {
+		<-t.ready
+		return false
+	}
+	timer := time.NewTimer(g.timeout)
+	defer timer.Stop()
+	select {
+	case <-t.ready:
+		return false
+	case <-timer.C:
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for i, other := range g.waiting {
+		if other == t {
+			// Still waiting: take a slot beyond the limit.
+			g.waiting = append(g.waiting[:i], g.waiting[i+1:]...)
+			g.running++
+			return true
+		}
+	}
+	// The slot was handed over while the timer fired.
+	return false
+}
+
+// release ends the run of t, handing its slot over to the first waiting
+// activation if any.
+func (g *overlapGuard) release(t *ticket) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.admitted, t)
+	if len(g.waiting) > 0 && g.running <= g.limit {
+		next := g.waiting[0]
+		g.waiting = g.waiting[1:]
+		close(next.ready)
+		return
+	}
+	g.running--
+}
@@ -0,0 +1,131 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestOverlapGuardUnlimited(t *testing.T) {
+	g := newOverlapGuard(OverlapAllow, 0, 0)
+	for i := 0; i < 3; i++ {
+		if d, _ := g.admit(func() {}); d != DispatchStarted {
+			t.Fatalf("expected every activation started, got %v", d)
+		}
+	}
+}
+
+func TestOverlapGuardSkip(t *testing.T) {
+	g := newOverlapGuard(OverlapSkip, 1, 0)
+	d, running := g.admit(func() {})
+	if d != DispatchStarted {
+		t.Fatalf("expected first activation started, got %v", d)
+	}
+	if d, _ := g.admit(func() {}); d != DispatchSkipped {
+		t.Fatalf("expected overlapping activation skipped, got %v", d)
+	}
+	g.release(running)
+	if d, _ := g.admit(func() {}); d != DispatchStarted {
+		t.Fatalf("expected activation started once the run is over, got %v", d)
+	}
+}
+
+func TestOverlapGuardQueueInOrder(t *testing.T) {
+	g := newOverlapGuard(OverlapQueue, 1, 0)
+	d, running := g.admit(func() {})
+	if d != DispatchStarted {
+		t.Fatalf("expected first activation started, got %v", d)
+	}
+	d, first := g.admit(func() {})
+	if d != DispatchQueued {
+		t.Fatalf("expected overlapping activation queued, got %v", d)
+	}
+	_, second := g.admit(func() {})
+
+	g.release(running)
+	select {
+	case <-first.ready:
+	default:
+		t.Fatal("expected the first queued activation released")
+	}
+	select {
+	case <-second.ready:
+		t.Fatal("expected the second queued activation still waiting")
+	default:
+	}
+
+	g.release(first)
+	g.await(second)
+	g.release(second)
+	if d, _ := g.admit(func() {}); d != DispatchStarted {
+		t.Fatalf("expected activation started once every run is over, got %v", d)
+	}
+}
+
+func TestOverlapGuardMaxConcurrentRuns(t *testing.T) {
+	g := newOverlapGuard(OverlapSkip, 3, 0)
+	for i := 0; i < 3; i++ {
+		if d, _ := g.admit(func() {}); d != DispatchStarted {
+			t.Fatalf("expected activation %d started, got %v", i+1, d)
+		}
+	}
+	if d, _ := g.admit(func() {}); d != DispatchSkipped {
+		t.Fatalf("expected activation beyond the limit skipped, got %v", d)
+	}
+}
+
+func TestOverlapGuardBoundedQueue(t *testing.T) {
+	g := newOverlapGuard(OverlapQueue, 2, 1)
+	_, running := g.admit(func() {})
+	g.admit(func() {})
+	d, queued := g.admit(func() {})
+	if d != DispatchQueued {
+		t.Fatalf("expected activation beyond the limit queued, got %v", d)
+	}
+	if d, _ := g.admit(func() {}); d != DispatchSkipped {
+		t.Fatalf("expected activation beyond the queue depth skipped, got %v", d)
+	}
+
+	g.release(running)
+	g.await(queued)
+	if d, _ := g.admit(func() {}); d != DispatchQueued {
+		t.Fatalf("expected activation queued once the queue drained, got %v", d)
+	}
+}
+
+func TestOverlapGuardReplace(t *testing.T) {
+	g := newOverlapGuard(OverlapReplace, 1, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	_, running := g.admit(cancel)
+
+	d, replacing := g.admit(func() {})
+	if d != DispatchReplaced {
+		t.Fatalf("expected overlapping activation replacing the running one, got %v", d)
+	}
+	if ctx.Err() == nil {
+		t.Fatal("expected the running activation cancelled")
+	}
+
+	g.release(running)
+	if forced := g.await(replacing); forced {
+		t.Error("expected replacing activation started once the replaced one returned")
+	}
+}
+
+// A replacing activation starts anyway once the replaced run outlasts the
+// timeout.
+func TestOverlapGuardReplaceTimeout(t *testing.T) {
+	g := newOverlapGuard(OverlapReplace, 1, 0)
+	g.timeout = 10 * time.Millisecond
+	_, running := g.admit(func() {})
+	_, replacing := g.admit(func() {})
+
+	if forced := g.await(replacing); !forced {
+		t.Error("expected replacing activation forced to start")
+	}
+	g.release(running)
+	g.release(replacing)
+	if d, _ := g.admit(func() {}); d != DispatchStarted {
+		t.Fatalf("expected activation started once every run is over, got %v", d)
+	}
+}
@@ -0,0 +1,36 @@
+package cron
+
+// PanicPolicy configures the handling of the runs whose job panicked, see
+// [WithPanicPolicy]. Such runs are always recovered from, logged with the
+// stack of the panic, reported to the hooks and recorded as failed first.
+type PanicPolicy struct {
+	// Handler, if not nil, is called with the run that panicked, the value it
+	// panicked with and the stack of the panic, from the goroutine of the run.
+	Handler func(run RunInfo, value any, stack []byte)
+
+	// PauseAfter pauses the entry once that many of its runs panicked in a
+	// row, zero meaning never. The entry stays paused until resumed, see
+	// [Cron.Resume], which counts its panics afresh.
+	PauseAfter int
+
+	// Repanic panics again with the value recovered, once the run is handled,
+	// crashing the process, for it to be restarted by its supervisor.
+	Repanic bool
+}
+
+// handle applies the policy to the given run, which panicked with value, the
+// entry having panicked for the last panics runs in a row.
+func (p PanicPolicy) handle(c *Cron, entry *Entry, run RunInfo, result jobResult, panics int) {
+	if p.Handler != nil {
+		p.Handler(run, result.value, result.stack)
+	}
+	if p.PauseAfter > 0 && panics == p.PauseAfter {
+		entry.logger.Warn("pausing entry", "event", "panic", "panics", panics)
+		if err := c.Pause(entry.ID); err != nil {
+			entry.logger.Info("pausing entry failed", "event", "panic", "error", err)
+		}
+	}
+	if p.Repanic {
+		panic(result.value)
+	}
+}
@@ -0,0 +1,132 @@
+package cron
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The handler of the panic policy receives the run that panicked, the value
+// and the stack.
+func TestPanicPolicyHandler(t *testing.T) {
+	type call struct {
+		run   RunInfo
+		value any
+		stack []byte
+	}
+	calls := make(chan call, 1)
+	cron := New(WithPanicPolicy(PanicPolicy{Handler: func(run RunInfo, value any, stack []byte) {
+		calls <- call{run, value, stack}
+	}}))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := cron.Schedule(sched, func() { panic("boom") }, EntryName("boom"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	defer cron.Stop()
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+
+	c := <-calls
+	if c.run.ID != id || c.run.Name != "boom" {
+		t.Errorf("expected the run of the entry, got %+v", c.run)
+	}
+	if c.value != "boom" {
+		t.Errorf("expected the value panicked with, got %v", c.value)
+	}
+	if !strings.Contains(string(c.stack), "panic") {
+		t.Errorf("expected the stack of the panic, got %q", c.stack)
+	}
+}
+
+// An entry is paused once its runs panicked in a row the given number of
+// times.
+func TestPanicPolicyPauseAfter(t *testing.T) {
+	cron := New()
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	panics := true
+	id, err := cron.Schedule(sched, func() {
+		if panics {
+			panic("boom")
+		}
+	}, EntryPanicPolicy(PanicPolicy{PauseAfter: 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Stopping waits for the run, and its handling, to complete.
+	runOnce := func(panicking bool) {
+		panics = panicking
+		cron.Start()
+		if _, err := cron.Trigger(id); err != nil {
+			t.Fatal(err)
+		}
+		<-cron.Stop().Done()
+	}
+
+	runOnce(true)
+	runOnce(false)
+	runOnce(true)
+	if cron.Entry(id).Paused {
+		t.Fatal("expected the entry not paused, its panics interrupted by a success")
+	}
+	runOnce(true)
+	if !cron.Entry(id).Paused {
+		t.Fatal("expected the entry paused after two panics in a row")
+	}
+
+	// Once resumed, the entry is paused again should it keep panicking.
+	if err := cron.Resume(id); err != nil {
+		t.Fatal(err)
+	}
+	runOnce(true)
+	if cron.Entry(id).Paused {
+		t.Fatal("expected the entry not paused, its panics counted afresh")
+	}
+	runOnce(true)
+	if !cron.Entry(id).Paused {
+		t.Error("expected the entry paused again after two more panics")
+	}
+}
+
+// With Repanic, a panic of a job crashes the process.
+func TestPanicPolicyRepanic(t *testing.T) {
+	if os.Getenv("CRON_TEST_REPANIC") == "1" {
+		cron := New(WithPanicPolicy(PanicPolicy{Repanic: true}))
+		sched, err := secondParser.Parse("0 0 0 1 1 ?")
+		if err != nil {
+			t.Fatal(err)
+		}
+		id, err := cron.Schedule(sched, func() { panic("boom") })
+		if err != nil {
+			t.Fatal(err)
+		}
+		cron.Start()
+		if _, err := cron.Trigger(id); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Second)
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestPanicPolicyRepanic$")
+	cmd.Env = append(os.Environ(), "CRON_TEST_REPANIC=1")
+	out, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("expected the process to crash, got %v", err)
+	}
+	if !strings.Contains(string(out), "panic: boom") {
+		t.Errorf("expected the job panic to crash the process, got:\n%s", out)
+	}
+}
@@ -0,0 +1,34 @@
+package cron
+
+// RestartPolicy tells what becomes of the activations the scheduler missed
+// while stopped, when started again, see [WithRestartPolicy].
+type RestartPolicy int
+
+const (
+	// RestartResume resumes the entries from the restart on, their next
+	// activations being computed from then: the ones missed while stopped are
+	// dropped, without being reported.
+	RestartResume RestartPolicy = iota
+	// RestartCatchUp resumes the entries from the activations they were due to
+	// run next when stopped: the ones missed while stopped are handled by the
+	// misfire policy of each entry, see [Misfire].
+	RestartCatchUp
+)
+
+func (p RestartPolicy) String() string {
+	switch p {
+	case RestartResume:
+		return "resume"
+	case RestartCatchUp:
+		return "catch up"
+	default:
+		return "unknown"
+	}
+}
+
+// resumes reports whether e, on a start of the scheduler, keeps its next
+// activation as computed before, rather than having it computed from the
+// start.
+func (p RestartPolicy) resumes(e *Entry) bool {
+	return p == RestartCatchUp && !e.Next.IsZero() && !e.restored
+}
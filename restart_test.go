@@ -0,0 +1,102 @@
+package cron
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+// Across a Stop and a Start, the entries keep their Prev times, and the
+// activations missed in between are handled according to the restart policy.
+func TestRestartPolicy(t *testing.T) {
+	cases := []struct {
+		policy RestartPolicy
+		wake   time.Time
+		want   []time.Time
+	}{
+		// The entry resumes from the restart, at +4h.
+		{RestartResume, start.Add(4 * time.Hour), []time.Time{start.Add(4 * time.Hour)}},
+		// The activations at +2h and +3h, missed while stopped, are both fired.
+		{RestartCatchUp, start.Add(3*time.Hour + 30*time.Minute), []time.Time{start.Add(2 * time.Hour), start.Add(3 * time.Hour)}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.policy.String(), func(t *testing.T) {
+			clock := newLateClock(start)
+			cron := New(WithClock(clock), WithRestartPolicy(tc.policy), WithMisfire(Misfire{Policy: MisfireFireAll}))
+			sched, err := secondParser.Parse("0 0 * * * ?")
+			if err != nil {
+				t.Fatal(err)
+			}
+			runs := make(chan time.Time, 10)
+			id, err := cron.ScheduleContext(sched, func(ctx context.Context) error {
+				run, _ := RunInfoFromContext(ctx)
+				runs <- run.Scheduled
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			cron.Start()
+			clock.wakeAt(start.Add(time.Hour))
+			<-runs
+			<-cron.Stop().Done()
+			// Drop the timer the stopped scheduler was sleeping on.
+			<-clock.armed
+			if prev := cron.Entry(id).Prev; !prev.Equal(start.Add(time.Hour)) {
+				t.Fatalf("expected Prev kept at +1h, got %v", prev)
+			}
+
+			clock.mu.Lock()
+			clock.now = start.Add(3*time.Hour + 30*time.Minute)
+			clock.mu.Unlock()
+			cron.Start()
+			clock.wakeAt(tc.wake)
+			<-cron.Stop().Done()
+
+			close(runs)
+			var got []time.Time
+			for scheduled := range runs {
+				got = append(got, scheduled)
+			}
+			// The runs are concurrent, so they report in any order.
+			slices.SortFunc(got, time.Time.Compare)
+			if !slices.EqualFunc(got, tc.want, time.Time.Equal) {
+				t.Errorf("expected runs for %v after the restart, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// The runs still in progress once stopped keep holding back the overlapping
+// ones after a restart.
+func TestRestartOverlapGuard(t *testing.T) {
+	cron := New()
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	id, err := cron.Schedule(sched, func() {
+		close(started)
+		<-release
+	}, EntrySkipIfRunning())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	<-started
+	done := cron.Stop()
+
+	cron.Start()
+	if dispatch, _ := cron.Trigger(id); dispatch != DispatchSkipped {
+		t.Errorf("expected the run in progress to hold back the triggered one, got %v", dispatch)
+	}
+	close(release)
+	<-done.Done()
+	<-cron.Stop().Done()
+}
@@ -0,0 +1,84 @@
+package cron
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// DefaultRetryBackoff is the delay before the first retry of a failed run,
+// unless configured otherwise.
+const DefaultRetryBackoff = time.Second
+
+// RetryPolicy configures the retries of the failed runs of a job, see
+// [EntryRetry]. A run fails when its job returns an error or panics; a run
+// whose context was cancelled, by [Cron.Stop] or by a replacing activation, is
+// not retried.
+//
+// Retries go through the overlap policy of the entry like any other
+// activation, keeping the activation time of the failed run. The job can tell
+// them apart by the attempt number of its run, see [RunInfoFromContext].
+type RetryPolicy struct {
+	// MaxAttempts bounds the attempts at running the job for an activation,
+	// the first one included. Less than 2 means no retry.
+	MaxAttempts int
+
+	// Backoff is the delay before the first retry, zero meaning
+	// DefaultRetryBackoff. Each retry multiplies it by Multiplier for the next.
+	Backoff time.Duration
+
+	// Multiplier grows the delay between successive retries. Values below 1
+	// mean 2.
+	Multiplier float64
+
+	// MaxBackoff bounds the delay between retries, zero meaning no bound.
+	MaxBackoff time.Duration
+
+	// Jitter randomizes each delay by up to this fraction of it, either way, so
+	// that jobs failing together do not retry together. It is clamped to [0, 1].
+	Jitter float64
+
+	// RetryIf tells whether the run that failed with err is to be retried. Nil
+	// retries every failure.
+	RetryIf func(err error) bool
+}
+
+// retries reports whether the run that failed at the given attempt with err is
+// to be retried.
+func (p RetryPolicy) retries(attempt int, err error) bool {
+	return attempt < p.MaxAttempts && (p.RetryIf == nil || p.RetryIf(err))
+}
+
+// backoff returns the delay before retrying the run that failed at the given
+// attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.Backoff)
+	if p.Backoff <= 0 {
+		d = float64(DefaultRetryBackoff)
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	d *= math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 {
+		d = min(d, float64(p.MaxBackoff))
+	}
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		d += d * jitter * (2*rand.Float64() - 1)
+	}
+	// Clamp before converting: float64(math.MaxInt64) rounds up to 2^63, which
+	// overflows back into a negative duration.
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+// pendingRetry is a retry of a failed run waiting in the scheduler for its
+// time.
+type pendingRetry struct {
+	entry *Entry
+	run   RunInfo
+	at    time.Time
+}
@@ -0,0 +1,174 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if got := p.backoff(attempt + 1); got != want {
+			t.Errorf("expected backoff %v after attempt %d, got %v", want, attempt+1, got)
+		}
+	}
+
+	// With no bound, the backoff saturates rather than overflowing.
+	p = RetryPolicy{Backoff: time.Minute}
+	for _, attempt := range []int{28, 29, 100, 2000} {
+		if got := p.backoff(attempt); got <= 0 {
+			t.Errorf("expected a positive backoff after attempt %d, got %v", attempt, got)
+		}
+	}
+	if got := p.backoff(2000); got != math.MaxInt64 {
+		t.Errorf("expected the backoff capped at the longest duration, got %v", got)
+	}
+
+	p = RetryPolicy{Multiplier: 3, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		if got := p.backoff(2); got < 1500*time.Millisecond || got > 4500*time.Millisecond {
+			t.Fatalf("expected backoff within 50%% of 3s, got %v", got)
+		}
+	}
+}
+
+func TestRetryPolicyRetries(t *testing.T) {
+	errTransient := errors.New("transient")
+	p := RetryPolicy{MaxAttempts: 3, RetryIf: func(err error) bool { return errors.Is(err, errTransient) }}
+	if !p.retries(1, errTransient) || !p.retries(2, errTransient) {
+		t.Error("expected transient failures retried")
+	}
+	if p.retries(3, errTransient) {
+		t.Error("expected no retry past the max attempts")
+	}
+	if p.retries(1, errors.New("permanent")) {
+		t.Error("expected permanent failure not retried")
+	}
+	if (RetryPolicy{}).retries(1, errTransient) {
+		t.Error("expected no retry by default")
+	}
+}
+
+// A failed run is retried until it succeeds, up to the max attempts, the job
+// being told the attempt it runs for.
+func TestEntryRetry(t *testing.T) {
+	cron := New()
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	attempts := make(chan int, 10)
+	fail := func(ctx context.Context) error {
+		run, _ := RunInfoFromContext(ctx)
+		attempts <- run.Attempt
+		return errors.New("failed")
+	}
+	retry := RetryPolicy{MaxAttempts: 3, Backoff: 10 * time.Millisecond}
+	id, err := cron.ScheduleContext(sched, fail, EntryRetry(retry))
+	if err != nil {
+		t.Fatal(err)
+	}
+	noRetry, err := cron.ScheduleContext(sched, fail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	defer cron.Stop()
+
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	var got []int
+	for len(got) < 3 {
+		select {
+		case attempt := <-attempts:
+			got = append(got, attempt)
+		case <-time.After(time.Second):
+			t.Fatalf("expected 3 attempts, got %v", got)
+		}
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("expected attempts 1 to 3, got %v", got)
+	}
+
+	if _, err := cron.Trigger(noRetry); err != nil {
+		t.Fatal(err)
+	}
+	<-attempts
+	select {
+	case attempt := <-attempts:
+		t.Errorf("expected no more attempt, got attempt %d", attempt)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if entry := cron.Entry(id); entry.ConsecutiveFailures != 3 {
+		t.Errorf("expected 3 consecutive failures, got %d", entry.ConsecutiveFailures)
+	}
+}
+
+// The retries of a removed entry are dropped.
+func TestRetryDroppedOnRemove(t *testing.T) {
+	cron := New(WithRetry(RetryPolicy{MaxAttempts: 2, Backoff: 50 * time.Millisecond}))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	attempts := make(chan int, 10)
+	id, err := cron.ScheduleContext(sched, func(ctx context.Context) error {
+		run, _ := RunInfoFromContext(ctx)
+		attempts <- run.Attempt
+		return errors.New("failed")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	defer cron.Stop()
+
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	<-attempts
+	cron.Remove(id)
+	select {
+	case attempt := <-attempts:
+		t.Errorf("expected the retry dropped, got attempt %d", attempt)
+	case <-time.After(150 * time.Millisecond):
+	}
+}
+
+// The lag of a retry is not reported on its entry, the retry starting late
+// after its activation time by design.
+func TestEntryRetryLastLag(t *testing.T) {
+	cron := New()
+	var failed atomic.Bool
+	id, err := cron.ScheduleContext(must(every(time.Second)), func(context.Context) error {
+		if failed.CompareAndSwap(false, true) {
+			return errors.New("failed")
+		}
+		return nil
+	}, EntryRetry(RetryPolicy{MaxAttempts: 2, Backoff: 300 * time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	defer cron.Stop()
+
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		if records, _ := cron.History(id); len(records) == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the failed run retried")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if lag := cron.Entry(id).LastLag; lag >= 300*time.Millisecond {
+		t.Errorf("expected no lag reported for the retry, got %v", lag)
+	}
+}
@@ -0,0 +1,20 @@
+package cron
+
+// Selector selects entries by their labels: an entry is matched if it has
+// every label of the selector, with the same value. The empty selector matches
+// every entry.
+type Selector map[string]string
+
+// Matches reports whether the given labels are matched by the selector.
+func (s Selector) Matches(labels map[string]string) bool {
+	for k, v := range s {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Selector) matches(e *Entry) bool {
+	return s.Matches(e.Labels)
+}
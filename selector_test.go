@@ -0,0 +1,89 @@
+package cron
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSelectorMatches(t *testing.T) {
+	labels := map[string]string{"tenant": "acme", "team": "billing"}
+	cases := []struct {
+		selector Selector
+		want     bool
+	}{
+		{nil, true},
+		{Selector{"tenant": "acme"}, true},
+		{Selector{"tenant": "acme", "team": "billing"}, true},
+		{Selector{"tenant": "globex"}, false},
+		{Selector{"tenant": "acme", "region": "eu"}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.selector.Matches(labels); got != tc.want {
+			t.Errorf("expected %v to match %v: %v, got %v", tc.selector, labels, tc.want, got)
+		}
+	}
+}
+
+// Bulk operations act on the entries matched by their selector only.
+func TestMatchingOperations(t *testing.T) {
+	clock := NewTimerSkippingInstantExecutionClock(start)
+	cron := New(WithClock(clock))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schedule := func(labels map[string]string) ID {
+		id, err := cron.Schedule(sched, func() {}, EntryLabels(labels))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	acme1 := schedule(map[string]string{"tenant": "acme", "team": "billing"})
+	acme2 := schedule(map[string]string{"tenant": "acme", "team": "reports"})
+	globex := schedule(map[string]string{"tenant": "globex", "team": "billing"})
+	cron.Start()
+	defer cron.Stop()
+
+	ids := func(entries []Entry) []ID {
+		var ids []ID
+		for _, e := range entries {
+			ids = append(ids, e.ID)
+		}
+		slices.Sort(ids)
+		return ids
+	}
+	if got := ids(cron.EntriesMatching(Selector{"team": "billing"})); !slices.Equal(got, []ID{acme1, globex}) {
+		t.Errorf("expected the billing entries, got %v", got)
+	}
+
+	if n := cron.PauseMatching(Selector{"tenant": "acme"}); n != 2 {
+		t.Errorf("expected 2 entries paused, got %d", n)
+	}
+	for _, e := range cron.Entries() {
+		if paused := e.ID != globex; e.Paused != paused || e.Next.IsZero() != paused {
+			t.Errorf("expected entry %d paused: %v, got %+v", e.ID, paused, e)
+		}
+	}
+	if n := cron.ResumeMatching(Selector{"tenant": "acme"}); n != 2 {
+		t.Errorf("expected 2 entries resumed, got %d", n)
+	}
+	if entry := cron.Entry(acme2); entry.Paused || entry.Next.IsZero() {
+		t.Errorf("expected entry resumed, got %+v", entry)
+	}
+
+	removed := cron.RemoveMatching(Selector{"tenant": "acme"})
+	slices.Sort(removed)
+	if !slices.Equal(removed, []ID{acme1, acme2}) {
+		t.Errorf("expected the acme entries removed, got %v", removed)
+	}
+	if got := ids(cron.Entries()); !slices.Equal(got, []ID{globex}) {
+		t.Errorf("expected only the globex entry left, got %v", got)
+	}
+
+	// The entry left is still scheduled.
+	if next := cron.Entry(globex).Next; !next.Equal(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the entry left scheduled, got %v", next)
+	}
+}
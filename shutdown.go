@@ -0,0 +1,127 @@
+package cron
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultShutdownGrace is how long [Cron.Shutdown] waits for the jobs once it
+// has cancelled their context, unless configured otherwise.
+const DefaultShutdownGrace = 5 * time.Second
+
+// ShutdownError is returned by [Cron.Shutdown] when jobs still had not returned
+// at the end of the grace period.
+type ShutdownError struct {
+	// Entries are snapshots of the entries whose jobs were still running.
+	Entries []Entry
+
+	// Err is the error of the context given to Shutdown.
+	Err error
+}
+
+func (e *ShutdownError) Error() string {
+	names := make([]string, len(e.Entries))
+	for i, entry := range e.Entries {
+		names[i] = fmt.Sprint(entry.ID)
+		if entry.Name != "" {
+			names[i] += fmt.Sprintf(" (%s)", entry.Name)
+		}
+	}
+	return fmt.Sprintf("cron shutdown: jobs still running for entries %s: %v", strings.Join(names, ", "), e.Err)
+}
+
+func (e *ShutdownError) Unwrap() error {
+	return e.Err
+}
+
+// Shutdown stops the scheduler, then waits for the running jobs to return, in
+// phases like http.Server.Shutdown does for connections. Until ctx is done, the
+// jobs are left to complete; they are then asked to return, their context
+// being cancelled, and waited for a grace period, see [WithShutdownGrace].
+//
+// Shutdown returns nil if the jobs returned before ctx was done, and the error
+// of ctx if they did within the grace period. Otherwise, it returns a
+// *ShutdownError listing the entries whose jobs were still running. Runs
+// abandoned are not waited for, see [RunTimeout].
+func (c *Cron) Shutdown(ctx context.Context) error {
+	c.runningMu.Lock()
+	stopped := c.running
+	if stopped {
+		c.halt()
+	}
+	cancel := c.cancel
+	c.runningMu.Unlock()
+	if stopped {
+		c.hooks.OnSchedulerStop(c.clock.Now())
+	}
+
+	if cancel != nil {
+		defer cancel()
+	}
+
+	done := c.jobsDone()
+	select {
+	case <-done.Done():
+		return nil
+	case <-ctx.Done():
+	}
+	c.logger.Info("cancelling running jobs", "event", "shutdown", "grace", c.shutdownGrace)
+	if cancel != nil {
+		cancel()
+	}
+	timer := time.NewTimer(c.shutdownGrace)
+	defer timer.Stop()
+	select {
+	case <-done.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+
+	// The entries are left to whoever holds runningMu once the scheduler is
+	// stopped, the panic policy of their runs pausing them for instance.
+	err := &ShutdownError{Err: ctx.Err()}
+	c.runningMu.Lock()
+	for _, e := range c.inflight.entries() {
+		err.Entries = append(err.Entries, e.snapshot())
+	}
+	c.runningMu.Unlock()
+	slices.SortFunc(err.Entries, func(a, b Entry) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return err
+}
+
+// runTracker keeps track of the entries with jobs running.
+type runTracker struct {
+	mu   sync.Mutex
+	runs map[*Entry]int
+}
+
+// add counts n more runs of e, n being negative for the runs over.
+func (t *runTracker) add(e *Entry, n int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.runs == nil {
+		t.runs = map[*Entry]int{}
+	}
+	t.runs[e] += n
+	if t.runs[e] <= 0 {
+		delete(t.runs, e)
+	}
+}
+
+// entries returns the entries with jobs running.
+func (t *runTracker) entries() []*Entry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	entries := make([]*Entry, 0, len(t.runs))
+	for e := range t.runs {
+		entries = append(entries, e)
+	}
+	return entries
+}
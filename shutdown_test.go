@@ -0,0 +1,160 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Shutdown returns nil once the running jobs have completed, their context
+// left alone.
+func TestShutdown(t *testing.T) {
+	cron := New()
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	var cancelled bool
+	id, err := cron.ScheduleContext(sched, func(ctx context.Context) error {
+		close(started)
+		time.Sleep(20 * time.Millisecond)
+		cancelled = ctx.Err() != nil
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	<-started
+
+	if err := cron.Shutdown(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if cancelled {
+		t.Error("expected the job context not cancelled")
+	}
+	if _, err := cron.Trigger(id); !errors.Is(err, ErrNotRunning) {
+		t.Errorf("expected the scheduler stopped, got %v", err)
+	}
+}
+
+// Once its context is done, Shutdown cancels the jobs, and returns the error
+// of its context if they return within the grace period.
+func TestShutdownCancel(t *testing.T) {
+	cron := New()
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	id, err := cron.ScheduleContext(sched, func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = cron.Shutdown(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline exceeded, got %v", err)
+	}
+	var shutdownErr *ShutdownError
+	if errors.As(err, &shutdownErr) {
+		t.Errorf("expected no job reported, got %v", shutdownErr)
+	}
+}
+
+// Jobs still running at the end of the grace period are reported.
+func TestShutdownError(t *testing.T) {
+	cron := New(WithShutdownGrace(10 * time.Millisecond))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{}, 1)
+	stuck, err := cron.Schedule(sched, func() {
+		started <- struct{}{}
+		<-release
+	}, EntryName("stuck"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cron.Schedule(sched, func() {}, EntryName("idle")); err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	if _, err := cron.Trigger(stuck); err != nil {
+		t.Fatal(err)
+	}
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = cron.Shutdown(ctx)
+	var shutdownErr *ShutdownError
+	if !errors.As(err, &shutdownErr) {
+		t.Fatalf("expected a ShutdownError, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline exceeded wrapped, got %v", err)
+	}
+	if len(shutdownErr.Entries) != 1 || shutdownErr.Entries[0].ID != stuck {
+		t.Errorf("expected the stuck entry reported, got %+v", shutdownErr.Entries)
+	}
+	if !strings.Contains(err.Error(), "stuck") {
+		t.Errorf("expected the entry name in the message, got %q", err)
+	}
+}
+
+// A failed run to be retried does not hold Shutdown back, the scheduler that
+// would retry it being stopped, and the context of the jobs is cancelled once
+// they have returned.
+func TestShutdownRetry(t *testing.T) {
+	cron := New()
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	var jobCtx context.Context
+	id, err := cron.ScheduleContext(sched, func(ctx context.Context) error {
+		jobCtx = ctx
+		close(started)
+		time.Sleep(20 * time.Millisecond)
+		return errors.New("failed")
+	}, EntryRetry(RetryPolicy{MaxAttempts: 3, Backoff: time.Hour}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := cron.Shutdown(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if jobCtx.Err() == nil {
+		t.Error("expected the context of the jobs cancelled")
+	}
+}
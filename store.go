@@ -0,0 +1,108 @@
+package cron
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+)
+
+// Store persists the state of entries across restarts of the process, see
+// [WithStore]. Entries are saved under their Name, those without one are not.
+//
+// Save is called from the goroutines running the jobs, so implementations must
+// be safe for concurrent use.
+type Store interface {
+	// Load returns the state saved under name, and false if none was.
+	Load(name string) (EntryState, bool, error)
+
+	// Save records the state of an entry under name, replacing any saved
+	// before.
+	Save(name string, state EntryState) error
+}
+
+// EntryState is the state of an entry that outlives the process, as saved to a
+// [Store] each time a run of the entry completes.
+type EntryState struct {
+	// Prev is the activation time of the last completed scheduled run.
+	Prev time.Time `json:"prev"`
+
+	// LastStart is the time the last completed run started.
+	LastStart time.Time `json:"last_start"`
+
+	// LastEnd is the time the last completed run returned.
+	LastEnd time.Time `json:"last_end"`
+
+	// LastError is the message of the error the last completed run failed with,
+	// empty if it succeeded.
+	LastError string `json:"last_error,omitempty"`
+
+	// ConsecutiveFailures counts the runs that failed in a row, up to the last
+	// completed one.
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+}
+
+// FileStore is a [Store] keeping the state of every entry in a single JSON
+// file, rewritten as a whole on each save.
+type FileStore struct {
+	mu     sync.Mutex
+	path   string
+	states map[string]EntryState
+}
+
+// NewFileStore returns a store backed by the file at path, loading the states
+// it holds if it exists.
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, states: map[string]EntryState{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.states); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Load returns the state saved under name, and false if none was.
+func (s *FileStore) Load(name string) (EntryState, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.states[name]
+	return state, ok, nil
+}
+
+// Save records the state under name, then writes the file to a temporary one,
+// synced to disk before being renamed over it, so that neither a crash nor a
+// power loss leaves it truncated.
+func (s *FileStore) Save(name string, state EntryState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states[name] = state
+	data, err := json.MarshalIndent(s.states, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,143 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cron.json")
+	store, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err := store.Load("report"); ok || err != nil {
+		t.Fatalf("expected no state saved, got %v and %v", ok, err)
+	}
+
+	saved := EntryState{Prev: start, LastStart: start, LastEnd: start.Add(time.Second), LastError: "failed", ConsecutiveFailures: 2}
+	if err := store.Save("report", saved); err != nil {
+		t.Fatal(err)
+	}
+
+	// The state survives the store.
+	store, err = NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, ok, err := store.Load("report")
+	if !ok || err != nil {
+		t.Fatalf("expected state saved, got %v and %v", ok, err)
+	}
+	if !state.Prev.Equal(saved.Prev) || !state.LastEnd.Equal(saved.LastEnd) || state.LastError != saved.LastError || state.ConsecutiveFailures != saved.ConsecutiveFailures {
+		t.Errorf("expected %+v, got %+v", saved, state)
+	}
+}
+
+// An entry with a name saves its state after each run, and gets it back when
+// scheduled by a Cron sharing the store, the activations it missed in between
+// being handled by the misfire policy.
+func TestWithStore(t *testing.T) {
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "cron.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sched, err := secondParser.Parse("0 0 * * * ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clock := newLateClock(start)
+	cron := New(WithClock(clock), WithStore(store))
+	_, err = cron.ScheduleContext(sched, func(context.Context) error {
+		return errors.New("failed")
+	}, EntryName("report"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cron.Schedule(sched, func() {}); err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	clock.wakeAt(start.Add(time.Hour))
+	<-cron.Stop().Done()
+
+	state, ok, _ := store.Load("report")
+	if !ok || !state.Prev.Equal(start.Add(time.Hour)) || state.LastError != "failed" || state.ConsecutiveFailures != 1 {
+		t.Fatalf("expected the run at %v saved, got %+v", start.Add(time.Hour), state)
+	}
+
+	// The process comes back three and a half hours later, having missed three
+	// activations.
+	clock = newLateClock(start.Add(4*time.Hour + 30*time.Minute))
+	cron = New(WithClock(clock), WithStore(store), WithMisfire(Misfire{Policy: MisfireFireAll}))
+	var runs atomic.Int32
+	id, err := cron.Schedule(sched, func() { runs.Add(1) }, EntryName("report"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := cron.Entry(id)
+	if !entry.Prev.Equal(start.Add(time.Hour)) || entry.LastError == nil || entry.ConsecutiveFailures != 1 {
+		t.Errorf("expected the entry restored, got %+v", entry)
+	}
+	cron.Start()
+	clock.wakeAt(start.Add(4*time.Hour + 30*time.Minute))
+	<-cron.Stop().Done()
+
+	if n := runs.Load(); n != 3 {
+		t.Errorf("expected the 3 missed activations fired, got %d runs", n)
+	}
+	state, _, _ = store.Load("report")
+	if !state.Prev.Equal(start.Add(4*time.Hour)) || state.LastError != "" || state.ConsecutiveFailures != 0 {
+		t.Errorf("expected the run at %v saved, got %+v", start.Add(4*time.Hour), state)
+	}
+}
+
+// blockingStore is a store whose saves block until released.
+type blockingStore struct {
+	saving  chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingStore) Load(string) (EntryState, bool, error) {
+	return EntryState{}, false, nil
+}
+
+func (s *blockingStore) Save(string, EntryState) error {
+	s.saving <- struct{}{}
+	<-s.release
+	return nil
+}
+
+// A slow store does not hold the scheduler back.
+func TestWithStoreSlow(t *testing.T) {
+	store := &blockingStore{saving: make(chan struct{}), release: make(chan struct{})}
+	cron := New(WithStore(store))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := cron.Schedule(sched, func() {}, EntryName("slow"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	<-store.saving
+
+	entries := make(chan []Entry)
+	go func() { entries <- cron.Entries() }()
+	select {
+	case <-entries:
+	case <-time.After(time.Second):
+		t.Error("expected the entries listed while the store is saving")
+	}
+	close(store.release)
+	<-cron.Stop().Done()
+}
@@ -0,0 +1,89 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+)
+
+var (
+	// ErrRunTimeout is the error of the runs that outlasted their timeout, see
+	// [RunTimeout]. It wraps the error returned by the job, if any.
+	ErrRunTimeout = errors.New("run timed out")
+	// ErrRunAbandoned is the error of the runs abandoned by the scheduler, their
+	// job not having returned in time once cancelled, see [RunTimeout].
+	ErrRunAbandoned = errors.New("run abandoned")
+)
+
+// RunTimeout bounds the runs of a job, see [WithRunTimeout].
+type RunTimeout struct {
+	// Timeout is how long a run may take before its context is cancelled, and
+	// the run recorded as timed out. Zero means no bound.
+	Timeout time.Duration
+
+	// AbandonAfter is how long the job of a run is waited for once its context
+	// is cancelled, by the timeout or by [Cron.Stop], before the run is
+	// abandoned: considered over, without the job having returned, so that its
+	// overlap guard and its worker are released. The goroutine of the job is
+	// left running, and counted by Stats.Abandoned until the job returns. Zero
+	// means runs are never abandoned.
+	AbandonAfter time.Duration
+}
+
+// jobResult is how the job of a run returned.
+type jobResult struct {
+	err      error
+	panicked bool
+	value    any
+	stack    []byte
+}
+
+// callJob calls the job of entry, recovering from panics.
+func callJob(ctx context.Context, entry *Entry) (result jobResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			const size = 64 << 10
+			buf := make([]byte, size)
+			buf = buf[:runtime.Stack(buf, false)]
+			err, ok := r.(error)
+			if !ok {
+				err = fmt.Errorf("%v", r)
+			}
+			result = jobResult{err: err, panicked: true, value: r, stack: buf}
+		}
+	}()
+	return jobResult{err: entry.job(ctx)}
+}
+
+// awaitJob calls the job of entry in its own goroutine, waiting for it to
+// return, unless ctx is done and it does not within grace. The run is then
+// abandoned, failing with ErrRunAbandoned, the goroutine being tracked by the
+// Cron until the job returns.
+func (c *Cron) awaitJob(ctx context.Context, entry *Entry, grace time.Duration) jobResult {
+	done := make(chan jobResult, 1)
+	go func() {
+		done <- callJob(ctx, entry)
+	}()
+	select {
+	case result := <-done:
+		return result
+	case <-ctx.Done():
+	}
+	timer := time.NewTimer(grace)
+	defer timer.Stop()
+	select {
+	case result := <-done:
+		return result
+	case <-timer.C:
+	}
+	entry.logger.Warn("job execution abandoned", "event", "abandon", "grace", grace)
+	c.abandoned.Add(1)
+	go func() {
+		result := <-done
+		c.abandoned.Add(-1)
+		entry.logger.Info("abandoned job execution returned", "event", "abandon", "error", result.err)
+	}()
+	return jobResult{err: ErrRunAbandoned}
+}
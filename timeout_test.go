@@ -0,0 +1,95 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// waitFor polls cond until it holds, failing the test after a second.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// A run outlasting its timeout gets its context cancelled, and is recorded as
+// timed out.
+func TestRunTimeout(t *testing.T) {
+	cron := New(WithRunTimeout(RunTimeout{Timeout: 20 * time.Millisecond}))
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := cron.ScheduleContext(sched, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return cron.Entry(id).LastError != nil })
+	<-cron.Stop().Done()
+
+	entry := cron.Entry(id)
+	if !errors.Is(entry.LastError, ErrRunTimeout) || !errors.Is(entry.LastError, context.DeadlineExceeded) {
+		t.Errorf("expected the run timed out, got %v", entry.LastError)
+	}
+	if records, _ := cron.History(id); len(records) != 1 || records[0].Outcome != OutcomeTimeout {
+		t.Errorf("expected a run timed out in the history, got %+v", records)
+	}
+}
+
+// A run whose job ignores the cancellation of its context is abandoned, so
+// that it holds back neither the overlap guard nor Stop.
+func TestRunTimeoutAbandon(t *testing.T) {
+	cron := New()
+	sched, err := secondParser.Parse("0 0 0 1 1 ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	release := make(chan struct{})
+	id, err := cron.Schedule(sched, func() { <-release },
+		EntrySkipIfRunning(),
+		EntryRunTimeout(RunTimeout{Timeout: 10 * time.Millisecond, AbandonAfter: 10 * time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cron.Start()
+	if _, err := cron.Trigger(id); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return cron.Stats().Abandoned == 1 })
+
+	if dispatch, _ := cron.Trigger(id); dispatch != DispatchStarted {
+		t.Errorf("expected the guard released by the abandoned run, got %v", dispatch)
+	}
+	select {
+	case <-cron.Stop().Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected Stop not held back by the abandoned runs")
+	}
+	// The second run is abandoned too, once cancelled by Stop or timed out.
+	waitFor(t, func() bool { return cron.Stats().Abandoned == 2 })
+	records, _ := cron.History(id)
+	if len(records) != 2 || records[0].Outcome != OutcomeTimeout || records[0].Error != "run timed out: run abandoned" {
+		t.Fatalf("expected the first run timed out and abandoned, got %+v", records)
+	}
+	if !strings.HasSuffix(records[1].Error, "run abandoned") {
+		t.Errorf("expected the second run abandoned, got %q", records[1].Error)
+	}
+
+	close(release)
+	waitFor(t, func() bool { return cron.Stats().Abandoned == 0 })
+}
@@ -0,0 +1,109 @@
+package cron
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Stats reports the load of the jobs of a Cron on its worker pool, see
+// [WithWorkerPool].
+type Stats struct {
+	// Workers is the number of jobs allowed to run at once, zero meaning no
+	// limit.
+	Workers int
+
+	// Busy is the number of jobs running.
+	Busy int
+
+	// Queued is the number of activations waiting for a worker.
+	Queued int
+
+	// MaxWait is how long the activation waiting the longest for a worker has
+	// been waiting, zero if none is.
+	MaxWait time.Duration
+
+	// Abandoned is the number of jobs still running whose run was abandoned,
+	// see [RunTimeout].
+	Abandoned int
+}
+
+// workerPool bounds the number of jobs running at once across all the entries
+// of a Cron. Activations beyond the bound wait for a worker by priority, then
+// in the order they asked for one.
+type workerPool struct {
+	mu sync.Mutex
+	// size is the number of workers, zero meaning no bound.
+	size    int
+	busy    int
+	waiting []*workerTicket
+}
+
+// workerTicket is an activation waiting for a worker.
+type workerTicket struct {
+	since    time.Time
+	priority int
+	// ready is closed once the activation has been given a worker.
+	ready chan struct{}
+}
+
+// acquire takes a worker for an activation with the given priority, queueing
+// it as of now if none is free. The returned channel is closed once the
+// activation has been given one; it is nil if it has been given one right away.
+// Either way, the activation must call release once its run is over.
+func (p *workerPool) acquire(now time.Time, priority int) <-chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.size == 0 || p.busy < p.size {
+		p.busy++
+		return nil
+	}
+	t := &workerTicket{since: now, priority: priority, ready: make(chan struct{})}
+	// Queue behind every activation with the same priority or a higher one.
+	i := len(p.waiting)
+	for i > 0 && p.waiting[i-1].priority < priority {
+		i--
+	}
+	p.waiting = slices.Insert(p.waiting, i, t)
+	return t.ready
+}
+
+// release frees a worker, handing it over to the first waiting activation if
+// any.
+func (p *workerPool) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.waiting) > 0 {
+		next := p.waiting[0]
+		p.waiting = p.waiting[1:]
+		close(next.ready)
+		return
+	}
+	p.busy--
+}
+
+// cancel takes the activation waiting on ready out of the queue, reporting
+// whether it was still waiting. If not, it has been given a worker, which it
+// must release.
+func (p *workerPool) cancel(ready <-chan struct{}) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, t := range p.waiting {
+		if t.ready == ready {
+			p.waiting = slices.Delete(p.waiting, i, i+1)
+			return true
+		}
+	}
+	return false
+}
+
+// stats reports the load of the pool as of now.
+func (p *workerPool) stats(now time.Time) Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := Stats{Workers: p.size, Busy: p.busy, Queued: len(p.waiting)}
+	for _, t := range p.waiting {
+		s.MaxWait = max(s.MaxWait, now.Sub(t.since))
+	}
+	return s
+}
@@ -0,0 +1,90 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkerPoolUnbounded(t *testing.T) {
+	p := &workerPool{}
+	for i := 0; i < 3; i++ {
+		if ready := p.acquire(start, 0); ready != nil {
+			t.Fatal("expected a worker given right away")
+		}
+	}
+	if s := p.stats(start); s.Busy != 3 || s.Queued != 0 {
+		t.Errorf("expected 3 busy workers and none queued, got %+v", s)
+	}
+}
+
+func TestWorkerPoolQueueInOrder(t *testing.T) {
+	p := &workerPool{size: 1}
+	if ready := p.acquire(start, 0); ready != nil {
+		t.Fatal("expected a worker given right away")
+	}
+	first := p.acquire(start, 0)
+	second := p.acquire(start.Add(time.Second), 0)
+	if first == nil || second == nil {
+		t.Fatal("expected activations beyond the pool size queued")
+	}
+
+	s := p.stats(start.Add(3 * time.Second))
+	if s.Workers != 1 || s.Busy != 1 || s.Queued != 2 || s.MaxWait != 3*time.Second {
+		t.Errorf("expected 1 busy worker, 2 queued activations waiting up to 3s, got %+v", s)
+	}
+
+	p.release()
+	select {
+	case <-first:
+	default:
+		t.Fatal("expected the first queued activation given the worker")
+	}
+	select {
+	case <-second:
+		t.Fatal("expected the second queued activation still waiting")
+	default:
+	}
+	p.release()
+	<-second
+	p.release()
+	if s := p.stats(start); s.Busy != 0 || s.Queued != 0 {
+		t.Errorf("expected an idle pool, got %+v", s)
+	}
+}
+
+func TestWorkerPoolQueueByPriority(t *testing.T) {
+	p := &workerPool{size: 1}
+	p.acquire(start, 0)
+	low := p.acquire(start, -1)
+	normal := p.acquire(start, 0)
+	high := p.acquire(start, 1)
+	highLater := p.acquire(start, 1)
+
+	for _, next := range []<-chan struct{}{high, highLater, normal, low} {
+		p.release()
+		select {
+		case <-next:
+		default:
+			t.Fatal("expected the highest priority activation, first come first, given the worker")
+		}
+	}
+}
+
+func TestWorkerPoolCancel(t *testing.T) {
+	p := &workerPool{size: 1}
+	p.acquire(start, 0)
+	first := p.acquire(start, 0)
+	second := p.acquire(start, 0)
+	if !p.cancel(first) {
+		t.Fatal("expected the first queued activation taken out of the queue")
+	}
+	p.release()
+	<-second
+	if p.cancel(second) {
+		t.Error("expected the activation given a worker not to be cancelled")
+	}
+	p.release()
+	if s := p.stats(start); s.Busy != 0 || s.Queued != 0 {
+		t.Errorf("expected an idle pool, got %+v", s)
+	}
+}